@@ -0,0 +1,441 @@
+// Command aoc runs the solutions for every registered day.
+//
+//	aoc                              run every part of every day
+//	aoc run -day 7 -part 2           run a single part
+//	aoc run -day 2 -input other.txt  run a day against a different input
+//	aoc verify                       check every answer against the answers file
+//	aoc accept -day 7                record the current answers as the known answers
+//	aoc bench -runs 20 -baseline b   time every part and compare against a saved baseline
+//	aoc bench -baseline b -save      time every part and save the timings as the new baseline
+//	aoc new -day 9                   create solutions/day-09 from the standard layout
+//	aoc hash -day 9                  record the sha256 of day 9's input so edits are noticed
+//
+// Inputs come from -input if it is given, then day-NN.txt in $AOC_INPUT_DIR, then the
+// input.txt embedded in each day.
+//
+// The solutions directory, with the answers file and the hashes of the embedded inputs, is
+// found from the working directory. Use -solutions or $AOC_SOLUTIONS_DIR to run from elsewhere.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/giodamelio/aoc-2020-go/logging"
+	"github.com/giodamelio/aoc-2020-go/solutions"
+	_ "github.com/giodamelio/aoc-2020-go/solutions/all"
+	"github.com/giodamelio/aoc-2020-go/solutions/scaffold"
+	"github.com/rs/zerolog"
+)
+
+type options struct {
+	command   string
+	day       int
+	part      int
+	input     string
+	answers   string
+	solutions string
+	verbose   bool
+	logging   logging.Config
+
+	// Only used by bench
+	runs      int
+	baseline  string
+	save      bool
+	threshold float64
+}
+
+func parseOptions(args []string, config logging.Config) (options, error) {
+	opts := options{command: "run", logging: config}
+
+	if len(args) > 0 && args[0] != "" && args[0][0] != '-' {
+		opts.command = args[0]
+		args = args[1:]
+	}
+
+	flags := flag.NewFlagSet("aoc "+opts.command, flag.ContinueOnError)
+	flags.IntVar(&opts.day, "day", 0, "day to run, defaults to every day")
+	flags.IntVar(&opts.part, "part", 0, "part to run, defaults to both")
+	flags.StringVar(&opts.input, "input", "", "file to use as the puzzle input instead of the embedded one")
+	flags.StringVar(&opts.answers, "answers", "", "file of known answers, defaults to the one in the solutions directory")
+	flags.StringVar(&opts.solutions, "solutions", "", "solutions directory, defaults to the one in the repository around the working directory")
+	flags.BoolVar(&opts.verbose, "verbose", false, "show everything the solutions log, the same as -log-level trace")
+	opts.logging.RegisterFlags(flags)
+	flags.IntVar(&opts.runs, "runs", 10, "how many times bench runs each part")
+	flags.StringVar(&opts.baseline, "baseline", "", "file of timings for bench to compare against")
+	flags.BoolVar(&opts.save, "save", false, "save the bench timings to the baseline file")
+	flags.Float64Var(&opts.threshold, "threshold", 0.1, "how much slower than the baseline counts as a regression, 0.1 is 10%")
+
+	if err := flags.Parse(args); err != nil {
+		return opts, err
+	}
+
+	return opts, nil
+}
+
+func main() {
+	// The computers log too much to see the answers by default
+	config, err := logging.DefaultConfig(zerolog.WarnLevel)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "aoc: %s\n", err)
+		os.Exit(2)
+	}
+
+	opts, err := parseOptions(os.Args[1:], config)
+	if err != nil {
+		os.Exit(2)
+	}
+
+	if opts.verbose {
+		opts.logging.Level = zerolog.TraceLevel
+	}
+
+	logger := opts.logging.New(os.Stderr)
+
+	// Stop the solutions cleanly on ctrl-c
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, logger, opts, os.Stdout); err != nil {
+		stop()
+		fmt.Fprintf(os.Stderr, "aoc: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+// A part of a day to run.
+type job struct {
+	solution solutions.Solution
+	part     int
+	input    solutions.Input
+}
+
+// Pick the days to use from the flags.
+func selectSolutions(opts options) ([]solutions.Solution, error) {
+	if opts.day == 0 {
+		if opts.input != "" {
+			return nil, fmt.Errorf("-input needs a -day to go with it")
+		}
+
+		return solutions.All(), nil
+	}
+
+	solution, ok := solutions.Lookup(opts.day)
+	if !ok {
+		return nil, fmt.Errorf("day %d is not solved yet", opts.day)
+	}
+
+	return []solutions.Solution{solution}, nil
+}
+
+// Pick what to run from the flags.
+func selectJobs(logger zerolog.Logger, opts options) ([]job, error) {
+	if opts.part < 0 || opts.part > 2 {
+		return nil, fmt.Errorf("part must be 1 or 2: %d", opts.part)
+	}
+
+	selected, err := selectSolutions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := solutions.NewInputProvider(opts.input, opts.solutions)
+
+	var jobs []job
+
+	for _, solution := range selected {
+		input, err := provider.Input(solution)
+
+		// Running every day should not stop at one that was only just scaffolded
+		if errors.Is(err, solutions.ErrNoInput) && opts.day == 0 {
+			logger.Warn().Err(err).Int("day", solution.Day()).Msg("Skipping day")
+
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		logger.
+			Debug().
+			Int("day", solution.Day()).
+			Str("source", string(input.Source)).
+			Str("path", input.Path).
+			Str("hash", string(input.Hash)).
+			Msg("Found input")
+
+		// Inputs from -input are never hashed, any other input should have been
+		if input.Hash == solutions.HashMissing && input.Source != solutions.PathSource {
+			logger.
+				Warn().
+				Int("day", solution.Day()).
+				Str("path", solutions.HashPath(input.Path)).
+				Msg("No hash for the input, edits to it will not be noticed")
+		}
+
+		for part := 1; part <= 2; part++ {
+			if opts.part == 0 || opts.part == part {
+				jobs = append(jobs, job{solution: solution, part: part, input: input})
+			}
+		}
+	}
+
+	return jobs, nil
+}
+
+func run(ctx context.Context, logger zerolog.Logger, opts options, out io.Writer) error {
+	if opts.solutions == "" {
+		dir, err := solutions.Dir()
+		if err != nil {
+			return err
+		}
+
+		opts.solutions = dir
+	}
+
+	if opts.answers == "" {
+		opts.answers = solutions.AnswersPath(opts.solutions)
+	}
+
+	switch opts.command {
+	case "new":
+		return newDay(opts, out)
+	case "hash":
+		return hashInputs(opts, out)
+	}
+
+	jobs, err := selectJobs(logger, opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.command == "bench" {
+		return bench(ctx, logger, opts, jobs, out)
+	}
+
+	answers, err := solutions.LoadAnswers(opts.answers)
+	if err != nil {
+		return err
+	}
+
+	// The known answers are for the embedded inputs
+	if opts.command == "verify" || opts.command == "accept" {
+		for _, j := range jobs {
+			if j.input.Source != solutions.EmbeddedSource {
+				return fmt.Errorf(
+					"day %d is using the %s input %s, answers are only known for the embedded input",
+					j.solution.Day(),
+					j.input.Source,
+					j.input.Path,
+				)
+			}
+		}
+	}
+
+	// What to do with each answer, returning false if it counts as a failure
+	var report func(j job, answer solutions.Answer, elapsed time.Duration) bool
+
+	switch opts.command {
+	case "run":
+		report = func(j job, answer solutions.Answer, elapsed time.Duration) bool {
+			fmt.Fprintf(out, "day %d part %d: %s (%s)\n", j.solution.Day(), j.part, answer, elapsed)
+
+			return true
+		}
+	case "verify":
+		report = func(j job, answer solutions.Answer, elapsed time.Duration) bool {
+			status := answers.Verify(j.solution.Day(), j.part, answer)
+			known, _ := answers.Get(j.solution.Day(), j.part)
+
+			switch status {
+			case solutions.Fail:
+				fmt.Fprintf(out, "day %d part %d: fail, expected %s but got %s\n", j.solution.Day(), j.part, known, answer)
+			default:
+				fmt.Fprintf(out, "day %d part %d: %s (%s)\n", j.solution.Day(), j.part, status, answer)
+			}
+
+			return status != solutions.Fail
+		}
+	case "accept":
+		report = func(j job, answer solutions.Answer, elapsed time.Duration) bool {
+			if known, ok := answers.Get(j.solution.Day(), j.part); ok && known != answer {
+				fmt.Fprintf(out, "day %d part %d: accepted %s, was %s\n", j.solution.Day(), j.part, answer, known)
+			} else {
+				fmt.Fprintf(out, "day %d part %d: accepted %s\n", j.solution.Day(), j.part, answer)
+			}
+
+			answers.Set(j.solution.Day(), j.part, answer)
+
+			return true
+		}
+	default:
+		return fmt.Errorf("unknown command: %s", opts.command)
+	}
+
+	failed := 0
+
+	for _, j := range jobs {
+		start := time.Now()
+		answer, err := solutions.Run(ctx, logger, j.solution, j.part, j.input.Text)
+		elapsed := time.Since(start)
+
+		if err != nil {
+			failed++
+
+			fmt.Fprintf(out, "day %d part %d: failed: %s (%s)\n", j.solution.Day(), j.part, err, elapsed)
+
+			continue
+		}
+
+		if !report(j, answer, elapsed) {
+			failed++
+		}
+	}
+
+	if opts.command == "accept" {
+		if err := answers.Save(opts.answers); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d parts failed", failed)
+	}
+
+	return nil
+}
+
+// Time every job and compare the timings against the baseline.
+func bench(ctx context.Context, logger zerolog.Logger, opts options, jobs []job, out io.Writer) error {
+	if opts.save && opts.baseline == "" {
+		return fmt.Errorf("-save needs a -baseline file to save to")
+	}
+
+	baseline := solutions.Baseline{}
+
+	if opts.baseline != "" {
+		loaded, err := solutions.LoadBaseline(opts.baseline)
+		if err != nil {
+			return err
+		}
+
+		baseline = loaded
+	}
+
+	failed := 0
+	regressed := 0
+
+	for _, j := range jobs {
+		day := j.solution.Day()
+
+		timing, err := solutions.Measure(ctx, logger, j.solution, j.part, j.input.Text, opts.runs)
+		if err != nil {
+			failed++
+
+			fmt.Fprintf(out, "day %d part %d: failed: %s\n", day, j.part, err)
+
+			continue
+		}
+
+		fmt.Fprintf(
+			out,
+			"day %d part %d: mean %s p50 %s p99 %s, %d allocs %d bytes",
+			day,
+			j.part,
+			timing.Mean,
+			timing.P50,
+			timing.P99,
+			timing.Allocs,
+			timing.Bytes,
+		)
+
+		if comparison, ok := baseline.Compare(day, j.part, timing); ok {
+			fmt.Fprintf(out, ", %+.1f%% time %+.1f%% allocs", comparison.Time*100, comparison.Allocs*100)
+
+			if !opts.save && comparison.Regressed(opts.threshold) {
+				regressed++
+
+				fmt.Fprint(out, " REGRESSION")
+			}
+		}
+
+		fmt.Fprintln(out)
+
+		if opts.save {
+			baseline.Set(day, j.part, timing)
+		}
+	}
+
+	if opts.save {
+		if err := baseline.Save(opts.baseline); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case failed > 0:
+		return fmt.Errorf("%d parts failed", failed)
+	case regressed > 0:
+		return fmt.Errorf("%d parts are slower than the baseline", regressed)
+	}
+
+	return nil
+}
+
+// Create the files for a day that is not solved yet.
+func newDay(opts options, out io.Writer) error {
+	if opts.day == 0 {
+		return fmt.Errorf("new needs a -day to create")
+	}
+
+	written, err := scaffold.Generate(opts.solutions, opts.day)
+	for _, path := range written {
+		fmt.Fprintf(out, "wrote %s\n", path)
+	}
+
+	return err
+}
+
+// Record the hash of every selected day's input.
+func hashInputs(opts options, out io.Writer) error {
+	selected, err := selectSolutions(opts)
+	if err != nil {
+		return err
+	}
+
+	provider := solutions.NewInputProvider(opts.input, opts.solutions)
+
+	for _, solution := range selected {
+		path, _ := provider.Locate(solution.Day())
+
+		// Hash the file on disk in case it changed since the embedded copy was built
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		// A hash of nothing would make the real input look like it changed
+		if strings.TrimSpace(string(raw)) == "" {
+			fmt.Fprintf(out, "day %d: no input in %s\n", solution.Day(), path)
+
+			continue
+		}
+
+		if err := solutions.WriteHash(path, string(raw)); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(out, "day %d: wrote %s\n", solution.Day(), solutions.HashPath(path))
+	}
+
+	return nil
+}
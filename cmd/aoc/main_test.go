@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/giodamelio/aoc-2020-go/logging"
+	"github.com/giodamelio/aoc-2020-go/solutions"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+// Run the command line with some arguments, returning what it printed.
+func runArgs(t *testing.T, args ...string) (string, error) {
+	return runLogged(t, zerolog.Nop(), args...)
+}
+
+func runLogged(t *testing.T, logger zerolog.Logger, args ...string) (string, error) {
+	config, err := logging.DefaultConfig(zerolog.WarnLevel)
+	assert.Nil(t, err)
+
+	opts, err := parseOptions(args, config)
+	assert.Nil(t, err)
+
+	var out strings.Builder
+
+	err = run(context.Background(), logger, opts, &out)
+
+	return out.String(), err
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "aoc")
+	assert.Nil(t, err)
+
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	return dir
+}
+
+func TestRun(t *testing.T) {
+	out, err := runArgs(t, "run", "-day", "1", "-part", "1")
+
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(out, "day 1 part 1: 3232358 ("), out)
+}
+
+func TestVerify(t *testing.T) {
+	path := filepath.Join(tempDir(t), "answers.json")
+
+	answers := solutions.Answers{}
+	answers.Set(1, 1, "3232358")
+	answers.Set(1, 2, "1")
+	assert.Nil(t, answers.Save(path))
+
+	out, err := runArgs(t, "verify", "-day", "1", "-answers", path)
+
+	assert.Equal(t, "1 parts failed", err.Error())
+	assert.Equal(t, "day 1 part 1: pass (3232358)\nday 1 part 2: fail, expected 1 but got 4845669\n", out)
+}
+
+func TestVerifySolutionsDir(t *testing.T) {
+	dir := tempDir(t)
+
+	// The answers file comes from the solutions directory unless it is given
+	answers := solutions.Answers{}
+	answers.Set(1, 1, "3232358")
+	assert.Nil(t, answers.Save(solutions.AnswersPath(dir)))
+
+	out, err := runArgs(t, "verify", "-day", "1", "-solutions", dir)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "day 1 part 1: pass (3232358)\nday 1 part 2: unknown (4845669)\n", out)
+}
+
+func TestAccept(t *testing.T) {
+	path := filepath.Join(tempDir(t), "answers.json")
+
+	out, err := runArgs(t, "accept", "-day", "1", "-answers", path)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "day 1 part 1: accepted 3232358\nday 1 part 2: accepted 4845669\n", out)
+
+	answers, err := solutions.LoadAnswers(path)
+	assert.Nil(t, err)
+	assert.Equal(t, solutions.Answers{1: {1: "3232358", 2: "4845669"}}, answers)
+
+	// Accepting over a different answer says what it was
+	answers.Set(1, 2, "1")
+	assert.Nil(t, answers.Save(path))
+
+	out, err = runArgs(t, "accept", "-day", "1", "-part", "2", "-answers", path)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "day 1 part 2: accepted 4845669, was 1\n", out)
+}
+
+func TestMissingHash(t *testing.T) {
+	var logs strings.Builder
+
+	_, err := runLogged(t, zerolog.New(&logs).Level(zerolog.WarnLevel), "run", "-day", "1", "-part", "1")
+	assert.Nil(t, err)
+	assert.Empty(t, logs.String())
+
+	// A solutions directory somewhere else has none of the hashes
+	_, err = runLogged(t, zerolog.New(&logs).Level(zerolog.WarnLevel), "run", "-day", "1", "-part", "1", "-solutions", tempDir(t))
+	assert.Nil(t, err)
+	assert.Contains(t, logs.String(), "No hash for the input, edits to it will not be noticed")
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/intcode/ascii"
+	"github.com/giodamelio/aoc-2020-go/intcode/scheduler"
+)
+
+// Reads a whole line at a time, first from the script and then from stdin.
+//
+// Scripted lines are echoed so they show up like they were typed, lines from stdin are
+// already on the terminal so they only go to the transcript.
+type lineReader struct {
+	script     *bufio.Reader
+	stdin      *bufio.Reader
+	out        io.Writer
+	transcript io.Writer
+	// The rest of the last line when it did not fit in the buffer
+	pending string
+}
+
+// Copy as much of a line as fits and keep the rest for the next read.
+func (r *lineReader) fill(buffer []byte, line string) int {
+	n := copy(buffer, line)
+	r.pending = line[n:]
+
+	return n
+}
+
+func (r *lineReader) Read(buffer []byte) (int, error) {
+	if r.pending != "" {
+		return r.fill(buffer, r.pending), nil
+	}
+
+	if r.script != nil {
+		line, err := r.script.ReadString('\n')
+		if line != "" {
+			if !strings.HasSuffix(line, "\n") {
+				line += "\n"
+			}
+
+			fmt.Fprint(r.out, line)
+
+			return r.fill(buffer, line), nil
+		}
+
+		if err != nil && err != io.EOF {
+			return 0, err
+		}
+
+		r.script = nil
+	}
+
+	line, err := r.stdin.ReadString('\n')
+	if line != "" {
+		fmt.Fprint(r.transcript, line)
+
+		return r.fill(buffer, line), nil
+	}
+
+	return 0, err
+}
+
+// Run a program that reads and writes one number per line.
+func runNumeric(computer *intcode.Computer, in io.Reader, out io.Writer) error {
+	runner := scheduler.New()
+	runner.Logger = computer.Logger()
+	process := runner.Add(computer)
+
+	process.OnOutput = func(value intcode.AddressValue) error {
+		_, err := fmt.Fprintf(out, "%d\n", value)
+
+		return err
+	}
+
+	reader := bufio.NewReader(in)
+
+	for !runner.Done() {
+		if err := runner.Round(); err != nil {
+			return err
+		}
+
+		if !process.Waiting() {
+			continue
+		}
+
+		fmt.Fprint(out, "> ")
+
+		line, err := reader.ReadString('\n')
+		if line == "" && err != nil {
+			return ascii.ErrInputEnded
+		}
+
+		number, err := strconv.Atoi(strings.TrimSpace(line))
+		if err != nil {
+			return fmt.Errorf("input must be a number: %w", err)
+		}
+
+		process.Send(intcode.AddressValue(number))
+	}
+
+	return nil
+}
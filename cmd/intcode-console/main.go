@@ -0,0 +1,175 @@
+// Command intcode-console runs an intcode program with its input and output on the terminal.
+//
+//	intcode-console -program input.txt -mode ascii -script moves.txt -transcript session.txt
+//
+// A session can be saved with -record and checked later with the replay package.
+//
+// In ascii mode commands are typed a line at a time, in numeric mode every line is a single
+// number. Lines from the script are run first and echoed, then the console reads stdin.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/intcode/ascii"
+	"github.com/giodamelio/aoc-2020-go/intcode/replay"
+	"github.com/giodamelio/aoc-2020-go/logging"
+	"github.com/rs/zerolog"
+)
+
+type options struct {
+	program    string
+	mode       string
+	profile    string
+	script     string
+	transcript string
+	record     string
+	verbose    bool
+	logger     zerolog.Logger
+}
+
+func main() {
+	var opts options
+
+	// The computer logs too much to play along with by default
+	config, err := logging.DefaultConfig(zerolog.WarnLevel)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "intcode-console: %s\n", err)
+		os.Exit(2)
+	}
+
+	flag.StringVar(&opts.program, "program", "", "intcode program to run")
+	flag.StringVar(&opts.mode, "mode", "ascii", "how to read and write values, ascii or numeric")
+	flag.StringVar(&opts.profile, "profile", "", "instruction set profile to run with, defaults to everything")
+	flag.StringVar(&opts.script, "script", "", "file of input lines to run before reading stdin")
+	flag.StringVar(&opts.transcript, "transcript", "", "file to record the whole session to")
+	flag.StringVar(&opts.record, "record", "", "file to save a replay log of every value read and written to")
+	flag.BoolVar(&opts.verbose, "verbose", false, "log every instruction the computer runs, the same as -log-level trace")
+	config.RegisterFlags(flag.CommandLine)
+	flag.Parse()
+
+	if opts.verbose {
+		config.Level = zerolog.TraceLevel
+	}
+
+	opts.logger = config.New(os.Stderr)
+
+	if err := run(opts, os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "intcode-console: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func loadComputer(opts options) (*intcode.Computer, error) {
+	if opts.program == "" {
+		return nil, fmt.Errorf("-program is required")
+	}
+
+	rawProgram, err := ioutil.ReadFile(opts.program)
+	if err != nil {
+		return nil, err
+	}
+
+	program, err := intcode.ParseInput(string(rawProgram))
+	if err != nil {
+		return nil, err
+	}
+
+	computer := intcode.NewComputer(program)
+
+	if opts.profile != "" {
+		profile, err := intcode.LookupProfile(opts.profile)
+		if err != nil {
+			return nil, err
+		}
+
+		computer = intcode.NewComputerWithProfile(program, profile)
+	}
+
+	computer.SetLogger(opts.logger)
+
+	return computer, nil
+}
+
+func run(opts options, stdin io.Reader, stdout io.Writer) error {
+	computer, err := loadComputer(opts)
+	if err != nil {
+		return err
+	}
+
+	var recorded *replay.Log
+	if opts.record != "" {
+		recorded = replay.Record(computer)
+	}
+
+	out := stdout
+	transcript := ioutil.Discard
+
+	if opts.transcript != "" {
+		file, err := os.Create(opts.transcript)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		transcript = file
+		out = io.MultiWriter(stdout, file)
+	}
+
+	in := &lineReader{
+		stdin:      bufio.NewReader(stdin),
+		out:        out,
+		transcript: transcript,
+	}
+
+	if opts.script != "" {
+		file, err := os.Open(opts.script)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		in.script = bufio.NewReader(file)
+	}
+
+	switch opts.mode {
+	case "ascii":
+		err = ascii.New(computer).Run(in, out)
+	case "numeric":
+		err = runNumeric(computer, in, out)
+	default:
+		return fmt.Errorf("unknown mode: %s", opts.mode)
+	}
+
+	// Running out of input is how the session gets ended early
+	if errors.Is(err, ascii.ErrInputEnded) {
+		fmt.Fprintln(out)
+
+		err = nil
+	}
+
+	if recorded != nil {
+		if saveErr := saveRecording(opts.record, recorded); saveErr != nil && err == nil {
+			err = saveErr
+		}
+	}
+
+	return err
+}
+
+func saveRecording(path string, recorded *replay.Log) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return recorded.Save(file)
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bufio"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+// Print a prompt, echo a line back and output a result.
+const echo = "104,62,104,32,3,100,4,100,1008,100,10,101,1006,101,4,104,1000,99"
+
+func writeProgram(t *testing.T, program string) string {
+	path := filepath.Join(t.TempDir(), "program.txt")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(program), 0o644))
+
+	return path
+}
+
+func TestLineReaderLongLine(t *testing.T) {
+	var transcript strings.Builder
+
+	reader := &lineReader{
+		stdin:      bufio.NewReader(strings.NewReader("abcdefg\nhi\n")),
+		transcript: &transcript,
+	}
+
+	buffer := make([]byte, 3)
+
+	var reads []string
+
+	for {
+		n, err := reader.Read(buffer)
+		if err != nil {
+			break
+		}
+
+		reads = append(reads, string(buffer[:n]))
+	}
+
+	assert.Equal(t, []string{"abc", "def", "g\n", "hi\n"}, reads)
+	assert.Equal(t, "abcdefg\nhi\n", transcript.String())
+}
+
+func TestRunASCIILongLine(t *testing.T) {
+	// Longer than the buffer the adapter reads commands with
+	line := strings.Repeat("a", 5000)
+
+	var out strings.Builder
+
+	err := run(options{
+		program: writeProgram(t, echo),
+		mode:    "ascii",
+		logger:  zerolog.Nop(),
+	}, strings.NewReader(line+"\n"), &out)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "> "+line+"\n1000\n", out.String())
+}
+
+func TestRunASCIIResultsInline(t *testing.T) {
+	// Output "hi", a result and then "ok" each on their own line
+	program := "104,104,104,105,104,10,104,300,104,111,104,107,104,10,99"
+
+	var out strings.Builder
+
+	err := run(options{
+		program: writeProgram(t, program),
+		mode:    "ascii",
+		logger:  zerolog.Nop(),
+	}, strings.NewReader(""), &out)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "hi\n300\nok\n", out.String())
+}
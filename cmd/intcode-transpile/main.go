@@ -0,0 +1,60 @@
+// Command intcode-transpile turns an intcode program into a native Go function.
+//
+//	intcode-transpile -in input.txt -package programs -func Day02 -out day02.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/intcode/transpiler"
+)
+
+func main() {
+	in := flag.String("in", "", "intcode program to transpile")
+	out := flag.String("out", "", "file to write the generated source to, defaults to stdout")
+	packageName := flag.String("package", "main", "package of the generated source")
+	functionName := flag.String("func", "Run", "name of the generated function")
+	flag.Parse()
+
+	if err := run(*in, *out, *packageName, *functionName); err != nil {
+		fmt.Fprintf(os.Stderr, "intcode-transpile: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(in string, out string, packageName string, functionName string) error {
+	if in == "" {
+		return fmt.Errorf("-in is required")
+	}
+
+	rawProgram, err := ioutil.ReadFile(in)
+	if err != nil {
+		return err
+	}
+
+	program, err := intcode.ParseInput(string(rawProgram))
+	if err != nil {
+		return err
+	}
+
+	source, err := transpiler.Generate(program, transpiler.Options{
+		Package:  packageName,
+		Function: functionName,
+		Source:   in,
+	})
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		_, err = os.Stdout.Write(source)
+
+		return err
+	}
+
+	return ioutil.WriteFile(out, source, 0o644)
+}
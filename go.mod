@@ -3,7 +3,7 @@ module github.com/giodamelio/aoc-2020-go
 go 1.16
 
 require (
-	github.com/gitchander/permutation v0.0.0-20210302120832-6ab79d7de174 // indirect
-	github.com/rs/zerolog v1.21.0 // indirect
-	github.com/stretchr/testify v1.7.0 // indirect
+	github.com/gitchander/permutation v0.0.0-20210302120832-6ab79d7de174
+	github.com/rs/zerolog v1.21.0
+	github.com/stretchr/testify v1.7.0
 )
@@ -0,0 +1,151 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+)
+
+// Instruction is a single instruction decoded from an intcode image.
+type Instruction struct {
+	Address    intcode.AddressLocation
+	Raw        intcode.AddressValue
+	Opcode     intcode.Opcode
+	Modes      []intcode.Mode
+	Parameters []intcode.AddressValue
+}
+
+// Decode the instruction that starts at address using the standard instruction set.
+func Decode(program []intcode.AddressValue, address intcode.AddressLocation) (Instruction, error) {
+	return DecodeWith(intcode.DefaultInstructionSet(), program, address)
+}
+
+// DecodeWith decodes the instruction that starts at address without executing it.
+func DecodeWith(
+	instructionSet *intcode.InstructionSet,
+	program []intcode.AddressValue,
+	address intcode.AddressLocation,
+) (Instruction, error) {
+	if address < 0 || int(address) >= len(program) {
+		return Instruction{}, fmt.Errorf("address outside of program: %d", address)
+	}
+
+	raw := program[address]
+
+	// The opcode lives in the 1s and 10s columns
+	opcode, ok := instructionSet.Get(raw % 100)
+	if !ok {
+		return Instruction{}, fmt.Errorf("invalid opcode: %d", raw%100)
+	}
+
+	parameterCount := len(opcode.Parameters)
+	if int(address)+parameterCount >= len(program) {
+		return Instruction{}, fmt.Errorf("truncated instruction: %s needs %d parameters", opcode.Name, parameterCount)
+	}
+
+	// Each parameter mode is one column to the left of the previous one
+	modes := make([]intcode.Mode, parameterCount)
+	divisor := intcode.AddressValue(100)
+
+	for i, readWrite := range opcode.Parameters {
+		mode := intcode.Mode((raw / divisor) % 10)
+		divisor *= 10
+
+		if !instructionSet.AllowsMode(mode) {
+			return Instruction{}, fmt.Errorf("parameter mode %d (%s) is not available", mode, mode)
+		}
+
+		switch mode {
+		case intcode.Position, intcode.Relative:
+		case intcode.Immediate:
+			if readWrite == intcode.Write {
+				return Instruction{}, fmt.Errorf("write parameter cannot be in immediate mode: %d", raw)
+			}
+		default:
+			return Instruction{}, fmt.Errorf("invalid mode: %d", mode)
+		}
+
+		modes[i] = mode
+	}
+
+	parameters := make([]intcode.AddressValue, parameterCount)
+	copy(parameters, program[address+1:int(address)+1+parameterCount])
+
+	return Instruction{
+		Address:    address,
+		Raw:        raw,
+		Opcode:     opcode,
+		Modes:      modes,
+		Parameters: parameters,
+	}, nil
+}
+
+// Length is the number of addresses the instruction occupies.
+func (i Instruction) Length() int {
+	return 1 + len(i.Parameters)
+}
+
+// Next is the address of the instruction directly after this one.
+func (i Instruction) Next() intcode.AddressLocation {
+	return i.Address + intcode.AddressLocation(i.Length())
+}
+
+// IsJump reports if the instruction can change the instruction pointer.
+func (i Instruction) IsJump() bool {
+	return i.Opcode.Opcode == intcode.JUMPIFTRUE || i.Opcode.Opcode == intcode.JUMPIFFALSE
+}
+
+// StaticCondition reports if a jump is taken when its condition is in immediate mode.
+func (i Instruction) StaticCondition() (taken bool, known bool) {
+	if !i.IsJump() || i.Modes[0] != intcode.Immediate {
+		return false, false
+	}
+
+	return (i.Parameters[0] != 0) == (i.Opcode.Opcode == intcode.JUMPIFTRUE), true
+}
+
+// IsIndirectJump reports if the instruction can jump to an address read from memory.
+func (i Instruction) IsIndirectJump() bool {
+	if !i.IsJump() || i.Modes[1] == intcode.Immediate {
+		return false
+	}
+
+	taken, known := i.StaticCondition()
+
+	return taken || !known
+}
+
+// Writes returns the addresses the instruction writes to.
+//
+// Relative mode writes are left out, where they land depends on the relative base at runtime.
+func (i Instruction) Writes() []intcode.AddressLocation {
+	var writes []intcode.AddressLocation
+
+	for index, readWrite := range i.Opcode.Parameters {
+		if readWrite == intcode.Write && i.Modes[index] == intcode.Position {
+			writes = append(writes, intcode.AddressLocation(i.Parameters[index]))
+		}
+	}
+
+	return writes
+}
+
+// String formats the instruction in the same syntax the assembler reads.
+func (i Instruction) String() string {
+	sections := make([]string, 0, i.Length())
+	sections = append(sections, i.Opcode.Name)
+
+	for index, parameter := range i.Parameters {
+		switch i.Modes[index] {
+		case intcode.Immediate:
+			sections = append(sections, fmt.Sprintf("i%d", parameter))
+		case intcode.Relative:
+			sections = append(sections, fmt.Sprintf("r%d", parameter))
+		default:
+			sections = append(sections, fmt.Sprintf("%d", parameter))
+		}
+	}
+
+	return strings.Join(sections, "\t")
+}
@@ -0,0 +1,94 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	instruction, err := Decode([]intcode.AddressValue{99, 1101, 11, 22, 0}, 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, intcode.AddressLocation(1), instruction.Address)
+	assert.Equal(t, "ADD", instruction.Opcode.Name)
+	assert.Equal(t, []intcode.Mode{intcode.Immediate, intcode.Immediate, intcode.Position}, instruction.Modes)
+	assert.Equal(t, []intcode.AddressValue{11, 22, 0}, instruction.Parameters)
+	assert.Equal(t, 4, instruction.Length())
+	assert.Equal(t, intcode.AddressLocation(5), instruction.Next())
+	assert.Equal(t, []intcode.AddressLocation{0}, instruction.Writes())
+	assert.Equal(t, "ADD\ti11\ti22\t0", instruction.String())
+}
+
+func TestDecodeRelative(t *testing.T) {
+	instruction, err := Decode([]intcode.AddressValue{21201, -1, 1, 3}, 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []intcode.Mode{intcode.Relative, intcode.Immediate, intcode.Relative}, instruction.Modes)
+	assert.Empty(t, instruction.Writes())
+	assert.Equal(t, "ADD\tr-1\ti1\tr3", instruction.String())
+
+	instruction, err = Decode([]intcode.AddressValue{2106, 0, 0}, 0)
+
+	assert.Nil(t, err)
+	assert.True(t, instruction.IsIndirectJump())
+}
+
+func TestDecodeErrors(t *testing.T) {
+	_, err := Decode([]intcode.AddressValue{1050}, 0)
+	assert.Equal(t, "invalid opcode: 50", err.Error())
+
+	_, err = Decode([]intcode.AddressValue{1, 0, 0}, 0)
+	assert.Equal(t, "truncated instruction: ADD needs 3 parameters", err.Error())
+
+	_, err = Decode([]intcode.AddressValue{11101, 0, 0, 0}, 0)
+	assert.Equal(t, "write parameter cannot be in immediate mode: 11101", err.Error())
+
+	_, err = Decode([]intcode.AddressValue{304, 0}, 0)
+	assert.Equal(t, "invalid mode: 3", err.Error())
+
+	_, err = Decode([]intcode.AddressValue{99}, 5)
+	assert.Equal(t, "address outside of program: 5", err.Error())
+}
+
+func TestDecodeWith(t *testing.T) {
+	set, err := intcode.DefaultInstructionSet().Subset(intcode.ADD, intcode.HALT)
+	assert.Nil(t, err)
+
+	_, err = DecodeWith(set, []intcode.AddressValue{1101, 1, 1, 0}, 0)
+	assert.Nil(t, err)
+
+	_, err = DecodeWith(set, []intcode.AddressValue{1102, 1, 1, 0}, 0)
+	assert.Equal(t, "invalid opcode: 2", err.Error())
+
+	_, err = DecodeWith(intcode.Day2Profile.InstructionSet(), []intcode.AddressValue{1101, 1, 1, 0}, 0)
+	assert.Equal(t, "parameter mode 1 (immediate) is not available", err.Error())
+}
+
+func TestStaticCondition(t *testing.T) {
+	cases := []struct {
+		program  []intcode.AddressValue
+		taken    bool
+		known    bool
+		indirect bool
+	}{
+		{[]intcode.AddressValue{1105, 1, 9}, true, true, false},
+		{[]intcode.AddressValue{1105, 0, 9}, false, true, false},
+		{[]intcode.AddressValue{1106, 0, 9}, true, true, false},
+		{[]intcode.AddressValue{1005, 3, 9}, false, false, false},
+		{[]intcode.AddressValue{105, 1, 9}, true, true, true},
+		{[]intcode.AddressValue{106, 1, 9}, false, true, false},
+		{[]intcode.AddressValue{5, 1, 9}, false, false, true},
+	}
+
+	for _, c := range cases {
+		instruction, err := Decode(c.program, 0)
+		assert.Nil(t, err)
+
+		taken, known := instruction.StaticCondition()
+		assert.Equal(t, c.taken, taken, c.program)
+		assert.Equal(t, c.known, known, c.program)
+		assert.Equal(t, c.indirect, instruction.IsIndirectJump(), c.program)
+	}
+}
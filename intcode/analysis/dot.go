@@ -0,0 +1,77 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+)
+
+func escapeLabel(label string) string {
+	label = strings.ReplaceAll(label, `\`, `\\`)
+	label = strings.ReplaceAll(label, `"`, `\"`)
+
+	return strings.ReplaceAll(label, "\t", " ")
+}
+
+func nodeName(address intcode.AddressLocation) string {
+	return fmt.Sprintf("b%d", address)
+}
+
+// DOT renders the graph in the Graphviz DOT language.
+func (g *Graph) DOT() string {
+	var out strings.Builder
+
+	out.WriteString("digraph cfg {\n")
+	out.WriteString("\tnode [shape=box, fontname=\"monospace\"];\n")
+	out.WriteString("\tentry [shape=point];\n")
+	fmt.Fprintf(&out, "\tentry -> %s;\n", nodeName(g.Entry))
+
+	for _, block := range g.Blocks {
+		var label strings.Builder
+
+		for _, instruction := range block.Instructions {
+			fmt.Fprintf(&label, "%d: %s\\l", instruction.Address, escapeLabel(instruction.String()))
+		}
+
+		fmt.Fprintf(&out, "\t%s [label=\"%s\"];\n", nodeName(block.Start), label.String())
+
+		for _, edge := range block.Successors {
+			style := ""
+
+			switch edge.Kind {
+			case Branch:
+				style = " [label=\"jump\"]"
+			case Call:
+				style = " [label=\"call\"]"
+			}
+
+			fmt.Fprintf(&out, "\t%s -> %s%s;\n", nodeName(block.Start), nodeName(edge.To), style)
+		}
+
+		if block.Indirect {
+			target := block.Last().Parameters[1]
+			fmt.Fprintf(&out, "\tindirect%d [shape=diamond, label=\"[%d]\"];\n", block.Start, target)
+			fmt.Fprintf(&out, "\t%s -> indirect%d [style=dashed];\n", nodeName(block.Start), block.Start)
+		}
+	}
+
+	invalid := make([]intcode.AddressLocation, 0, len(g.Invalid))
+	for address := range g.Invalid {
+		invalid = append(invalid, address)
+	}
+
+	for _, address := range sortAddresses(invalid) {
+		fmt.Fprintf(
+			&out,
+			"\t%s [color=red, label=\"%d: %s\"];\n",
+			nodeName(address),
+			address,
+			escapeLabel(g.Invalid[address]),
+		)
+	}
+
+	out.WriteString("}\n")
+
+	return out.String()
+}
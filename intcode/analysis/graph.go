@@ -0,0 +1,501 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+)
+
+type FindingKind int
+
+// Define the things worth pointing out about a program.
+const (
+	// A jump whose target is read from memory at runtime.
+	IndirectJump FindingKind = iota
+	// A write into an address that was decoded as code.
+	SelfModifyingWrite
+	// Code that is reachable but does not decode.
+	InvalidInstruction
+)
+
+func (k FindingKind) String() string {
+	switch k {
+	case IndirectJump:
+		return "indirect-jump"
+	case SelfModifyingWrite:
+		return "self-modifying-write"
+	case InvalidInstruction:
+		return "invalid-instruction"
+	default:
+		return fmt.Sprintf("finding(%d)", int(k))
+	}
+}
+
+// Finding is something the analysis could not resolve statically.
+type Finding struct {
+	Kind FindingKind
+	// The instruction responsible
+	Address intcode.AddressLocation
+	// The jump target, written address or address that failed to decode
+	Target  intcode.AddressLocation
+	Message string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%d: %s: %s", f.Address, f.Kind, f.Message)
+}
+
+type EdgeKind int
+
+// Define the ways control can pass from one block to another.
+const (
+	Fallthrough EdgeKind = iota
+	Branch
+	// Control goes into a function and comes back to the fall through edge of the same block
+	Call
+)
+
+type Edge struct {
+	To   intcode.AddressLocation
+	Kind EdgeKind
+}
+
+// BasicBlock is a run of instructions that always execute together.
+type BasicBlock struct {
+	Start        intcode.AddressLocation
+	Instructions []Instruction
+	Successors   []Edge
+	// The block ends in a jump whose target is only known at runtime
+	Indirect bool
+	// The block ends in a call, its successors are the function and then the return address
+	Call bool
+	// The block ends by jumping back to the return address of the function it is in
+	Return bool
+}
+
+// End is the address after the last instruction of the block.
+func (b *BasicBlock) End() intcode.AddressLocation {
+	return b.Last().Next()
+}
+
+// Last is the instruction that ends the block.
+func (b *BasicBlock) Last() Instruction {
+	return b.Instructions[len(b.Instructions)-1]
+}
+
+// Graph is the control flow graph of the reachable part of a program.
+type Graph struct {
+	Entry intcode.AddressLocation
+	// Sorted by start address
+	Blocks []*BasicBlock
+	// Start of every function that gets called, sorted
+	Functions []intcode.AddressLocation
+	Findings  []Finding
+	// Addresses that are reachable but failed to decode
+	Invalid        map[intcode.AddressLocation]string
+	blocks         map[intcode.AddressLocation]*BasicBlock
+	instructionSet *intcode.InstructionSet
+	// Jumps that look like calls but whose return address is stored somewhere else
+	notCalls map[intcode.AddressLocation]bool
+}
+
+// Block gets the block that starts at address.
+func (g *Graph) Block(address intcode.AddressLocation) (*BasicBlock, bool) {
+	block, ok := g.blocks[address]
+
+	return block, ok
+}
+
+// Predecessors gets the start addresses of every block with an edge into address.
+func (g *Graph) Predecessors(address intcode.AddressLocation) []intcode.AddressLocation {
+	var predecessors []intcode.AddressLocation
+
+	for _, block := range g.Blocks {
+		for _, edge := range block.Successors {
+			if edge.To == address {
+				predecessors = append(predecessors, block.Start)
+
+				break
+			}
+		}
+	}
+
+	return predecessors
+}
+
+// Analyze builds the control flow graph of a program starting at address 0.
+func Analyze(program []intcode.AddressValue) *Graph {
+	return AnalyzeFrom(program, 0)
+}
+
+// AnalyzeFrom builds the control flow graph of a program starting at entry.
+func AnalyzeFrom(program []intcode.AddressValue, entry intcode.AddressLocation) *Graph {
+	return AnalyzeWith(intcode.DefaultInstructionSet(), program, entry)
+}
+
+// AnalyzeWith builds the control flow graph of a program written for a custom instruction set.
+func AnalyzeWith(
+	instructionSet *intcode.InstructionSet,
+	program []intcode.AddressValue,
+	entry intcode.AddressLocation,
+) *Graph {
+	notCalls := make(map[intcode.AddressLocation]bool)
+
+	// A call only counts when its return address is stored right before the jump in the same
+	// block, which is only known once the blocks are built. Every rejected call is a plain
+	// jump on the next try, so this ends.
+	for {
+		graph := build(instructionSet, program, entry, notCalls)
+		if graph.rejectCalls() {
+			continue
+		}
+
+		graph.findReturns()
+
+		sort.SliceStable(graph.Findings, func(i, j int) bool {
+			return graph.Findings[i].Address < graph.Findings[j].Address
+		})
+
+		return graph
+	}
+}
+
+// Build the blocks of a program, treating the jumps in notCalls as plain jumps.
+func build(
+	instructionSet *intcode.InstructionSet,
+	program []intcode.AddressValue,
+	entry intcode.AddressLocation,
+	notCalls map[intcode.AddressLocation]bool,
+) *Graph {
+	graph := &Graph{
+		Entry:          entry,
+		Invalid:        make(map[intcode.AddressLocation]string),
+		blocks:         make(map[intcode.AddressLocation]*BasicBlock),
+		instructionSet: instructionSet,
+		notCalls:       notCalls,
+	}
+
+	decoded, successors := graph.decodeReachable(program)
+	leaders := findLeaders(entry, decoded, successors)
+
+	sortedLeaders := make([]intcode.AddressLocation, 0, len(leaders))
+	for leader := range leaders {
+		sortedLeaders = append(sortedLeaders, leader)
+	}
+
+	for _, leader := range sortAddresses(sortedLeaders) {
+		if _, ok := decoded[leader]; !ok {
+			continue
+		}
+
+		block := &BasicBlock{Start: leader}
+
+		for address := leader; ; {
+			instruction := decoded[address]
+			block.Instructions = append(block.Instructions, instruction)
+
+			next := instruction.Next()
+			_, nextIsLeader := leaders[next]
+			_, nextIsDecoded := decoded[next]
+
+			if instruction.IsJump() || instruction.Opcode.Opcode == intcode.HALT || nextIsLeader || !nextIsDecoded {
+				block.Successors = successors[address]
+				block.Indirect = instruction.IsIndirectJump()
+				block.Call = len(block.Successors) > 0 && block.Successors[0].Kind == Call
+
+				break
+			}
+
+			address = next
+		}
+
+		graph.Blocks = append(graph.Blocks, block)
+		graph.blocks[block.Start] = block
+	}
+
+	graph.findFunctions()
+	graph.findSelfModifyingWrites(decoded)
+
+	return graph
+}
+
+// Turn every call whose return address is not stored by the instruction right before the
+// jump back into a plain jump, reporting if there were any.
+func (g *Graph) rejectCalls() bool {
+	rejected := false
+
+	for _, block := range g.Blocks {
+		if !block.Call {
+			continue
+		}
+
+		count := len(block.Instructions)
+		jump := block.Last()
+
+		if count >= 2 && block.Instructions[count-2].Address == jump.Address-4 {
+			continue
+		}
+
+		g.notCalls[jump.Address] = true
+		rejected = true
+	}
+
+	return rejected
+}
+
+// Relative jumps inside a called function return from it, anywhere else they are only
+// indirect jumps.
+func (g *Graph) findReturns() {
+	inFunction := make(map[intcode.AddressLocation]bool)
+	worklist := append([]intcode.AddressLocation{}, g.Functions...)
+
+	for len(worklist) > 0 {
+		address := worklist[len(worklist)-1]
+		worklist = worklist[:len(worklist)-1]
+
+		block, ok := g.blocks[address]
+		if !ok || inFunction[address] {
+			continue
+		}
+
+		inFunction[address] = true
+
+		for _, edge := range block.Successors {
+			if edge.Kind != Call {
+				worklist = append(worklist, edge.To)
+			}
+		}
+	}
+
+	for _, block := range g.Blocks {
+		last := block.Last()
+		if !block.Indirect || last.Modes[1] != intcode.Relative {
+			continue
+		}
+
+		if inFunction[block.Start] {
+			block.Indirect = false
+			block.Return = true
+
+			continue
+		}
+
+		target := last.Parameters[1]
+		g.Findings = append(g.Findings, Finding{
+			Kind:    IndirectJump,
+			Address: last.Address,
+			Target:  intcode.AddressLocation(target),
+			Message: fmt.Sprintf("%s target is read from the relative base plus %d", last.Opcode.Name, target),
+		})
+	}
+}
+
+// Walk every path from the entry point decoding instructions as we go.
+func (g *Graph) decodeReachable(
+	program []intcode.AddressValue,
+) (map[intcode.AddressLocation]Instruction, map[intcode.AddressLocation][]Edge) {
+	decoded := make(map[intcode.AddressLocation]Instruction)
+	successors := make(map[intcode.AddressLocation][]Edge)
+	worklist := []intcode.AddressLocation{g.Entry}
+
+	for len(worklist) > 0 {
+		address := worklist[len(worklist)-1]
+		worklist = worklist[:len(worklist)-1]
+
+		if _, seen := decoded[address]; seen {
+			continue
+		}
+
+		if _, seen := g.Invalid[address]; seen {
+			continue
+		}
+
+		instruction, err := DecodeWith(g.instructionSet, program, address)
+		if err != nil {
+			g.Invalid[address] = err.Error()
+			g.Findings = append(g.Findings, Finding{
+				Kind:    InvalidInstruction,
+				Address: address,
+				Target:  address,
+				Message: err.Error(),
+			})
+
+			continue
+		}
+
+		decoded[address] = instruction
+		edges := g.instructionSuccessors(program, instruction)
+		successors[address] = edges
+
+		for _, edge := range edges {
+			worklist = append(worklist, edge.To)
+		}
+	}
+
+	return decoded, successors
+}
+
+// Figure out where control can go after an instruction.
+func (g *Graph) instructionSuccessors(program []intcode.AddressValue, instruction Instruction) []Edge {
+	switch instruction.Opcode.Opcode {
+	case intcode.HALT:
+		return nil
+	case intcode.JUMPIFTRUE, intcode.JUMPIFFALSE:
+	default:
+		return []Edge{{To: instruction.Next(), Kind: Fallthrough}}
+	}
+
+	target := instruction.Parameters[1]
+
+	if g.isCall(program, instruction) {
+		return []Edge{
+			{To: intcode.AddressLocation(target), Kind: Call},
+			{To: instruction.Next(), Kind: Fallthrough},
+		}
+	}
+
+	// Relative jumps are only reported once it is known they are not returns
+	if instruction.IsIndirectJump() && instruction.Modes[1] == intcode.Position {
+		g.Findings = append(g.Findings, Finding{
+			Kind:    IndirectJump,
+			Address: instruction.Address,
+			Target:  intcode.AddressLocation(target),
+			Message: fmt.Sprintf("%s target is read from address %d", instruction.Opcode.Name, target),
+		})
+	}
+
+	var edges []Edge
+
+	taken, known := instruction.StaticCondition()
+
+	if (taken || !known) && instruction.Modes[1] == intcode.Immediate {
+		edges = append(edges, Edge{To: intcode.AddressLocation(target), Kind: Branch})
+	}
+
+	if !taken || !known {
+		edges = append(edges, Edge{To: instruction.Next(), Kind: Fallthrough})
+	}
+
+	return edges
+}
+
+// Recognize a call, an unconditional jump right after storing the address following it
+// relative to the base. That is how compiled programs push the return address onto their stack.
+func (g *Graph) isCall(program []intcode.AddressValue, jump Instruction) bool {
+	if g.notCalls[jump.Address] {
+		return false
+	}
+
+	if taken, known := jump.StaticCondition(); !taken || !known || jump.Modes[1] != intcode.Immediate {
+		return false
+	}
+
+	// The store is an ADD or MULTIPLY, which are both 4 long
+	store, err := DecodeWith(g.instructionSet, program, jump.Address-4)
+	if err != nil {
+		return false
+	}
+
+	var value intcode.AddressValue
+
+	switch store.Opcode.Opcode {
+	case intcode.ADD:
+		value = store.Parameters[0] + store.Parameters[1]
+	case intcode.MULTIPLY:
+		value = store.Parameters[0] * store.Parameters[1]
+	default:
+		return false
+	}
+
+	if store.Modes[0] != intcode.Immediate || store.Modes[1] != intcode.Immediate || store.Modes[2] != intcode.Relative {
+		return false
+	}
+
+	return value == intcode.AddressValue(jump.Next())
+}
+
+// Collect the start of every function from the calls.
+func (g *Graph) findFunctions() {
+	seen := make(map[intcode.AddressLocation]bool)
+
+	for _, block := range g.Blocks {
+		if !block.Call || seen[block.Successors[0].To] {
+			continue
+		}
+
+		seen[block.Successors[0].To] = true
+		g.Functions = append(g.Functions, block.Successors[0].To)
+	}
+
+	sortAddresses(g.Functions)
+}
+
+// Flag every write that lands on an address we decoded as code.
+func (g *Graph) findSelfModifyingWrites(decoded map[intcode.AddressLocation]Instruction) {
+	code := make(map[intcode.AddressLocation]intcode.AddressLocation)
+
+	for _, instruction := range decoded {
+		for offset := 0; offset < instruction.Length(); offset++ {
+			code[instruction.Address+intcode.AddressLocation(offset)] = instruction.Address
+		}
+	}
+
+	for address := range g.Invalid {
+		code[address] = address
+	}
+
+	addresses := make([]intcode.AddressLocation, 0, len(decoded))
+	for address := range decoded {
+		addresses = append(addresses, address)
+	}
+
+	for _, address := range sortAddresses(addresses) {
+		instruction := decoded[address]
+
+		for _, write := range instruction.Writes() {
+			owner, isCode := code[write]
+			if !isCode {
+				continue
+			}
+
+			g.Findings = append(g.Findings, Finding{
+				Kind:    SelfModifyingWrite,
+				Address: instruction.Address,
+				Target:  write,
+				Message: fmt.Sprintf("%s writes to address %d inside the instruction at %d", instruction.Opcode.Name, write, owner),
+			})
+		}
+	}
+}
+
+// Block boundaries are the entry point, jump targets and the instructions after jumps.
+func findLeaders(
+	entry intcode.AddressLocation,
+	decoded map[intcode.AddressLocation]Instruction,
+	successors map[intcode.AddressLocation][]Edge,
+) map[intcode.AddressLocation]bool {
+	leaders := map[intcode.AddressLocation]bool{entry: true}
+
+	for address, instruction := range decoded {
+		if !instruction.IsJump() {
+			continue
+		}
+
+		for _, edge := range successors[address] {
+			leaders[edge.To] = true
+		}
+
+		leaders[instruction.Next()] = true
+	}
+
+	return leaders
+}
+
+func sortAddresses(addresses []intcode.AddressLocation) []intcode.AddressLocation {
+	sort.Slice(addresses, func(i, j int) bool {
+		return addresses[i] < addresses[j]
+	})
+
+	return addresses
+}
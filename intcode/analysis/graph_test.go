@@ -0,0 +1,249 @@
+package analysis
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/intcode/assembler"
+	"github.com/stretchr/testify/assert"
+)
+
+func blockStarts(graph *Graph) []intcode.AddressLocation {
+	starts := make([]intcode.AddressLocation, len(graph.Blocks))
+	for i, block := range graph.Blocks {
+		starts[i] = block.Start
+	}
+
+	return starts
+}
+
+func TestAnalyzeStraightLine(t *testing.T) {
+	graph := Analyze(assembler.Assemble(`
+	ADD		i11	i22	5
+	HALT
+	DATA	0
+	`))
+
+	assert.Equal(t, []intcode.AddressLocation{0}, blockStarts(graph))
+	assert.Len(t, graph.Blocks[0].Instructions, 2)
+	assert.Empty(t, graph.Blocks[0].Successors)
+	assert.Empty(t, graph.Findings)
+}
+
+func TestAnalyzeBranches(t *testing.T) {
+	graph := Analyze(assembler.Assemble(`
+	INPUT		10
+	JUMP-IF-FALSE	10	i9
+	ADD			11	12	11
+	HALT
+	DATA	-1
+	DATA	0
+	DATA	1
+	`))
+
+	assert.Equal(t, []intcode.AddressLocation{0, 5, 9}, blockStarts(graph))
+
+	entry, ok := graph.Block(0)
+	assert.True(t, ok)
+	assert.Equal(t, []Edge{{To: 9, Kind: Branch}, {To: 5, Kind: Fallthrough}}, entry.Successors)
+
+	fallthroughBlock, _ := graph.Block(5)
+	assert.Equal(t, []Edge{{To: 9, Kind: Fallthrough}}, fallthroughBlock.Successors)
+
+	assert.Equal(t, []intcode.AddressLocation{0, 5}, graph.Predecessors(9))
+	assert.Empty(t, graph.Findings)
+}
+
+func TestAnalyzeLoop(t *testing.T) {
+	// Count down from the input to zero
+	graph := Analyze(assembler.Assemble(`
+	INPUT		13
+	ADD			13	i-1	13
+	JUMP-IF-TRUE	13	i2
+	HALT
+	DATA	0
+	`))
+
+	assert.Equal(t, []intcode.AddressLocation{0, 2, 9}, blockStarts(graph))
+
+	loop, _ := graph.Block(2)
+	assert.Equal(t, []Edge{{To: 2, Kind: Branch}, {To: 9, Kind: Fallthrough}}, loop.Successors)
+}
+
+func TestAnalyzeUnconditionalJumpSkipsData(t *testing.T) {
+	graph := Analyze(assembler.Assemble(`
+	JUMP-IF-TRUE	i1	i4
+	DATA	-1
+	HALT
+	`))
+
+	assert.Equal(t, []intcode.AddressLocation{0, 4}, blockStarts(graph))
+	assert.Empty(t, graph.Invalid)
+}
+
+func TestAnalyzeIndirectJump(t *testing.T) {
+	graph := Analyze(assembler.Assemble(`
+	JUMP-IF-TRUE	i1	4
+	HALT
+	DATA	3
+	`))
+
+	assert.True(t, graph.Blocks[0].Indirect)
+	assert.Empty(t, graph.Blocks[0].Successors)
+	assert.Equal(t, []Finding{{
+		Kind:    IndirectJump,
+		Address: 0,
+		Target:  4,
+		Message: "JUMP-IF-TRUE target is read from address 4",
+	}}, graph.Findings)
+}
+
+// Call a function that sets m[50] then output it.
+const callProgram = `
+	ADJUST-RELATIVE-BASE	i100
+	ADD	i0	i9	r0
+	JUMP-IF-TRUE	i1	i12
+	OUTPUT	50
+	HALT
+	ADD	i0	i7	50
+	JUMP-IF-FALSE	i0	r0
+	`
+
+func TestAnalyzeCall(t *testing.T) {
+	graph := Analyze(assembler.Assemble(callProgram))
+
+	assert.Empty(t, graph.Findings)
+	assert.Equal(t, []intcode.AddressLocation{12}, graph.Functions)
+
+	assert.True(t, graph.Blocks[0].Call)
+	assert.Equal(t, []Edge{{To: 12, Kind: Call}, {To: 9, Kind: Fallthrough}}, graph.Blocks[0].Successors)
+
+	// The code after the call is reachable
+	_, ok := graph.Block(9)
+	assert.True(t, ok)
+
+	// The return goes back through the stack so it has nowhere to go statically
+	function, ok := graph.Block(12)
+	assert.True(t, ok)
+	assert.True(t, function.Return)
+	assert.False(t, function.Indirect)
+	assert.Empty(t, function.Successors)
+}
+
+func TestAnalyzeRelativeJumpOutsideFunction(t *testing.T) {
+	// Nothing calls this code so the jump can not be a return
+	graph := Analyze(assembler.Assemble(`
+	JUMP-IF-TRUE	i1	r2
+	HALT
+	`))
+
+	assert.True(t, graph.Blocks[0].Indirect)
+	assert.False(t, graph.Blocks[0].Return)
+	assert.Equal(t, []Finding{{
+		Kind:    IndirectJump,
+		Address: 0,
+		Target:  2,
+		Message: "JUMP-IF-TRUE target is read from the relative base plus 2",
+	}}, graph.Findings)
+}
+
+func TestAnalyzeCallStoreInAnotherBlock(t *testing.T) {
+	// The store before the jump is only run on one of the two ways into it
+	graph := Analyze(assembler.Assemble(`
+	INPUT	20
+	JUMP-IF-TRUE	20	i9
+	ADD	i0	i12	r0
+	JUMP-IF-TRUE	i1	i13
+	HALT
+	OUTPUT	20
+	HALT
+	`))
+
+	jump, ok := graph.Block(9)
+	assert.True(t, ok)
+	assert.False(t, jump.Call)
+	assert.Equal(t, []Edge{{To: 13, Kind: Branch}}, jump.Successors)
+	assert.Empty(t, graph.Functions)
+}
+
+func TestAnalyzeCallStoreInsideAnotherInstruction(t *testing.T) {
+	// 21101 at address 6 looks like a store of 13 when decoded, but the OUTPUT at 8 is what runs
+	graph := Analyze([]intcode.AddressValue{1105, 1, 8, 0, 0, 0, 21101, -91, 104, 5, 1105, 1, 14, 99, 99})
+
+	block, ok := graph.Block(8)
+	assert.True(t, ok)
+	assert.False(t, block.Call)
+	assert.Len(t, block.Instructions, 2)
+	assert.Equal(t, "OUTPUT", block.Instructions[0].Opcode.Name)
+}
+
+func TestAnalyzeJumpWithoutCall(t *testing.T) {
+	// The stored value is not the return address so this is a plain jump
+	graph := Analyze(assembler.Assemble(`
+	ADD	i0	i8	r0
+	JUMP-IF-TRUE	i1	i7
+	HALT
+	`))
+
+	assert.False(t, graph.Blocks[0].Call)
+	assert.Empty(t, graph.Functions)
+	assert.Equal(t, []Edge{{To: 7, Kind: Branch}}, graph.Blocks[0].Successors)
+}
+
+func TestAnalyzeSelfModifyingWrite(t *testing.T) {
+	// Patch the HALT into an OUTPUT before reaching it
+	graph := Analyze(assembler.Assemble(`
+	ADD		i0	i4	5
+	OUTPUT	0
+	HALT
+	`))
+
+	assert.Equal(t, []Finding{{
+		Kind:    SelfModifyingWrite,
+		Address: 0,
+		Target:  5,
+		Message: "ADD writes to address 5 inside the instruction at 4",
+	}}, graph.Findings)
+}
+
+func TestAnalyzeDay5(t *testing.T) {
+	rawInput, err := ioutil.ReadFile("../../solutions/day-05/input.txt")
+	assert.Nil(t, err)
+
+	program, err := intcode.ParseInput(string(rawInput))
+	assert.Nil(t, err)
+
+	graph := Analyze(program)
+
+	// The program patches its own second instruction with the input before running it
+	assert.Contains(t, graph.Findings, Finding{
+		Kind:    SelfModifyingWrite,
+		Address: 2,
+		Target:  6,
+		Message: "ADD writes to address 6 inside the instruction at 6",
+	})
+	assert.Equal(t, "invalid opcode: 0", graph.Invalid[6])
+}
+
+func TestDOT(t *testing.T) {
+	graph := Analyze(assembler.Assemble(`
+	INPUT		9
+	JUMP-IF-TRUE	9	i7
+	OUTPUT	9
+	HALT
+	`))
+
+	assert.Equal(t, `digraph cfg {
+	node [shape=box, fontname="monospace"];
+	entry [shape=point];
+	entry -> b0;
+	b0 [label="0: INPUT 9\l2: JUMP-IF-TRUE 9 i7\l"];
+	b0 -> b7 [label="jump"];
+	b0 -> b5;
+	b5 [label="5: OUTPUT 9\l"];
+	b5 -> b7;
+	b7 [label="7: HALT\l"];
+}
+`, graph.DOT())
+}
@@ -0,0 +1,170 @@
+package ascii
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/intcode/scheduler"
+)
+
+// ErrInputEnded is returned when the program wants more input than the reader has.
+var ErrInputEnded = errors.New("program is waiting for input but the input ended")
+
+// IsASCII checks if an output value is a character rather than a result.
+func IsASCII(value intcode.AddressValue) bool {
+	return value >= 0 && value <= 127
+}
+
+// Adapter talks to a program that reads and writes text one character at a time.
+type Adapter struct {
+	scheduler *scheduler.Scheduler
+	process   *scheduler.Process
+	// Lines finished since they were last collected
+	lines []string
+	// The line being written
+	partial strings.Builder
+	results []intcode.AddressValue
+	// Write results into the text where they were output
+	inline bool
+}
+
+// New wraps a computer in an adapter, the adapter takes over its channels.
+func New(computer *intcode.Computer) *Adapter {
+	adapter := &Adapter{scheduler: scheduler.New()}
+	adapter.scheduler.Logger = computer.Logger()
+	adapter.process = adapter.scheduler.Add(computer)
+	adapter.process.OnOutput = adapter.output
+
+	return adapter
+}
+
+func (a *Adapter) output(value intcode.AddressValue) error {
+	switch {
+	case !IsASCII(value):
+		a.results = append(a.results, value)
+
+		if a.inline {
+			a.partial.WriteString(strconv.FormatInt(int64(value), 10))
+			a.lines = append(a.lines, a.partial.String())
+			a.partial.Reset()
+		}
+	case value == '\n':
+		a.lines = append(a.lines, a.partial.String())
+		a.partial.Reset()
+	default:
+		a.partial.WriteByte(byte(value))
+	}
+
+	return nil
+}
+
+// Send a command, a newline is added if it does not already end with one.
+func (a *Adapter) Send(command string) error {
+	if !strings.HasSuffix(command, "\n") {
+		command += "\n"
+	}
+
+	values := make([]intcode.AddressValue, 0, len(command))
+
+	for _, character := range command {
+		if character > 127 {
+			return fmt.Errorf("command has a non ASCII character: %q", character)
+		}
+
+		values = append(values, intcode.AddressValue(character))
+	}
+
+	a.process.Send(values...)
+
+	return nil
+}
+
+// RunUntilInput runs until the program halts or has read everything that was sent.
+//
+// It returns the lines written since the last call.
+func (a *Adapter) RunUntilInput() ([]string, error) {
+	for !a.scheduler.Done() {
+		if err := a.scheduler.Round(); err != nil {
+			return nil, err
+		}
+
+		if a.process.Waiting() {
+			break
+		}
+	}
+
+	lines := a.lines
+	a.lines = nil
+
+	return lines, nil
+}
+
+// Partial is the text written after the last newline, like a prompt.
+func (a *Adapter) Partial() string {
+	return a.partial.String()
+}
+
+// Results lists every output that was not a character.
+func (a *Adapter) Results() []intcode.AddressValue {
+	return a.results
+}
+
+// Halted reports if the program has finished.
+func (a *Adapter) Halted() bool {
+	return a.scheduler.Done()
+}
+
+// Run the program reading commands a line at a time from in and writing its text to out.
+//
+// Results are written as they happen, ending the line they were output on.
+func (a *Adapter) Run(in io.Reader, out io.Writer) error {
+	a.inline = true
+	reader := bufio.NewReader(in)
+
+	for {
+		lines, err := a.RunUntilInput()
+		if err != nil {
+			return err
+		}
+
+		for _, line := range lines {
+			if _, err := fmt.Fprintln(out, line); err != nil {
+				return err
+			}
+		}
+
+		if a.Halted() {
+			if partial := a.Partial(); partial != "" {
+				_, err := fmt.Fprint(out, partial)
+
+				return err
+			}
+
+			return nil
+		}
+
+		if _, err := fmt.Fprint(out, a.Partial()); err != nil {
+			return err
+		}
+
+		a.partial.Reset()
+
+		command, err := reader.ReadString('\n')
+		if errors.Is(err, io.EOF) && command == "" {
+			return ErrInputEnded
+		}
+
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		if err := a.Send(command); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package ascii
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/stretchr/testify/assert"
+)
+
+// Pad a program with zeros so it has room for data.
+func pad(program []intcode.AddressValue, size int) []intcode.AddressValue {
+	padded := make([]intcode.AddressValue, size)
+	copy(padded, program)
+
+	return padded
+}
+
+// Print a prompt, echo a line back and output a result.
+var echo = pad([]intcode.AddressValue{
+	104, '>', // output('>')
+	104, ' ', // output(' ')
+	3, 100, // m[100] = input()
+	4, 100, // output(m[100])
+	1008, 100, '\n', 101, // m[101] = m[100] == '\n'
+	1006, 101, 4, // if m[101] == 0 goto 4
+	104, 1000, // output(1000)
+	99,
+}, 102)
+
+func TestIsASCII(t *testing.T) {
+	assert.True(t, IsASCII('a'))
+	assert.True(t, IsASCII('\n'))
+	assert.False(t, IsASCII(128))
+	assert.False(t, IsASCII(-1))
+}
+
+func TestRunUntilInput(t *testing.T) {
+	adapter := New(intcode.NewComputer(echo))
+
+	lines, err := adapter.RunUntilInput()
+	assert.Nil(t, err)
+	assert.Empty(t, lines)
+	assert.Equal(t, "> ", adapter.Partial())
+	assert.False(t, adapter.Halted())
+
+	assert.Nil(t, adapter.Send("hi"))
+
+	lines, err = adapter.RunUntilInput()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"> hi"}, lines)
+	assert.Equal(t, []intcode.AddressValue{1000}, adapter.Results())
+	assert.True(t, adapter.Halted())
+}
+
+func TestSendNonASCII(t *testing.T) {
+	adapter := New(intcode.NewComputer(echo))
+
+	assert.Equal(t, "command has a non ASCII character: 'é'", adapter.Send("café").Error())
+}
+
+func TestRun(t *testing.T) {
+	adapter := New(intcode.NewComputer(echo))
+
+	var out strings.Builder
+
+	assert.Nil(t, adapter.Run(strings.NewReader("hello\n"), &out))
+	assert.Equal(t, "> hello\n1000\n", out.String())
+	assert.Equal(t, []intcode.AddressValue{1000}, adapter.Results())
+}
+
+func TestRunInputEnded(t *testing.T) {
+	adapter := New(intcode.NewComputer(echo))
+
+	var out strings.Builder
+
+	assert.Equal(t, ErrInputEnded, adapter.Run(strings.NewReader(""), &out))
+	assert.Equal(t, "> ", out.String())
+}
+
+func TestRunResultEndsLine(t *testing.T) {
+	adapter := New(intcode.NewComputer([]intcode.AddressValue{104, 'n', 104, '=', 104, 300, 99}))
+
+	var out strings.Builder
+
+	assert.Nil(t, adapter.Run(strings.NewReader(""), &out))
+	assert.Equal(t, "n=300\n", out.String())
+}
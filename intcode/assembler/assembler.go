@@ -25,6 +25,15 @@ func parseArgument(argument string) (int, byte) {
 		return arg, '1'
 	}
 
+	if argument[0] == 'r' {
+		arg, err := strconv.Atoi(argument[1:])
+		if err != nil {
+			panic(err)
+		}
+
+		return arg, '2'
+	}
+
 	arg, err := strconv.Atoi(argument)
 	if err != nil {
 		panic(err)
@@ -33,22 +42,16 @@ func parseArgument(argument string) (int, byte) {
 	return arg, '0'
 }
 
-func buildOpcodeMap() map[string]intcode.Opcode {
-	opcodeMap := make(map[string]intcode.Opcode)
-	for _, opcodeDetails := range intcode.Opcodes {
-		opcodeMap[opcodeDetails.Name] = opcodeDetails
-	}
-
-	return opcodeMap
+// Assemble a program using the standard instruction set.
+func Assemble(programRaw string) []intcode.AddressValue {
+	return AssembleWith(intcode.DefaultInstructionSet(), programRaw)
 }
 
-func Assemble(programRaw string) []intcode.AddressValue {
+// AssembleWith assembles a program that uses a custom instruction set.
+func AssembleWith(instructionSet *intcode.InstructionSet, programRaw string) []intcode.AddressValue {
 	lines := strings.Split(strings.TrimSpace(programRaw), "\n")
 	program := make([]intcode.AddressValue, 0, len(lines))
 
-	// Build a map of TEXT to OPCODE mappings from the OPCODE to TEXT map
-	opcodeMap := buildOpcodeMap()
-
 	for _, line := range lines {
 		// Compact multiple tabs into one tab
 		re := regexp.MustCompile(`\t+`)
@@ -69,7 +72,10 @@ func Assemble(programRaw string) []intcode.AddressValue {
 		}
 
 		// Get opcode details using the first section
-		opcode := opcodeMap[rawInstruction]
+		opcode, ok := instructionSet.Lookup(rawInstruction)
+		if !ok {
+			panic(fmt.Errorf("unknown instruction: %s", rawInstruction))
+		}
 
 		// Get the count of arguments
 		argumentCount := len(sections[1:])
@@ -1,24 +1,12 @@
 package assembler
 
 import (
-	"os"
 	"testing"
 
 	"github.com/giodamelio/aoc-2020-go/intcode"
-	"github.com/rs/zerolog"
-	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
 )
 
-func init() {
-	out := zerolog.NewConsoleWriter()
-	out.Out = os.Stderr
-	out.NoColor = true
-	log.Logger = log.Output(out)
-
-	zerolog.SetGlobalLevel(zerolog.TraceLevel)
-}
-
 func TestPowInt(t *testing.T) {
 	assert.Equal(t, 256, powInt(4, 4))
 	assert.Equal(t, 4, powInt(2, 2))
@@ -36,6 +24,11 @@ func TestParseArgument(t *testing.T) {
 
 	assert.Equal(t, 10, argument)
 	assert.Equal(t, byte('1'), mode)
+
+	argument, mode = parseArgument("r-10")
+
+	assert.Equal(t, -10, argument)
+	assert.Equal(t, byte('2'), mode)
 }
 
 func TestSimpleHaltProgram(t *testing.T) {
@@ -76,6 +69,15 @@ func TestArgumentModes(t *testing.T) {
 	`)
 
 	assert.Equal(t, []intcode.AddressValue{1101, 10, 10, 0, 99}, program)
+
+	program = Assemble(`
+	ADJUST-RELATIVE-BASE	i1
+	OUTPUT	r-1
+	ADD	r0	i1	r0
+	HALT
+	`)
+
+	assert.Equal(t, []intcode.AddressValue{109, 1, 204, -1, 21201, 0, 1, 0, 99}, program)
 }
 
 func TestData(t *testing.T) {
@@ -88,6 +90,33 @@ func TestData(t *testing.T) {
 	assert.Equal(t, []intcode.AddressValue{1101, 10, 10, 0, 99, 10}, program)
 }
 
+func TestAssembleWith(t *testing.T) {
+	set := intcode.DefaultInstructionSet()
+	assert.Nil(t, set.Register(intcode.Opcode{
+		Name:       "NOOP",
+		Opcode:     50,
+		Parameters: []intcode.ReadWrite{intcode.Read},
+		Execute: func(computer *intcode.Computer, operation intcode.Opcode, parameters []intcode.AddressValue) error {
+			operation.IncrementInstructionPointer(computer)
+
+			return nil
+		},
+	}))
+
+	program := AssembleWith(set, `
+	NOOP	i10
+	HALT
+	`)
+
+	assert.Equal(t, []intcode.AddressValue{150, 10, 99}, program)
+}
+
+func TestUnknownInstruction(t *testing.T) {
+	assert.PanicsWithError(t, "unknown instruction: NOOP", func() {
+		Assemble("NOOP")
+	})
+}
+
 // Test some more complicated programs.
 func TestAddTwoNumber(t *testing.T) {
 	computer := intcode.NewComputer(Assemble(`
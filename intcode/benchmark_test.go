@@ -0,0 +1,139 @@
+package intcode
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func loadBenchmarkProgram(b *testing.B, day string) []AddressValue {
+	rawProgram, err := ioutil.ReadFile("../solutions/day-" + day + "/input.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	program, err := ParseInput(string(rawProgram))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return program
+}
+
+// Computer driven by Step on the benchmark goroutine, so goroutine scheduling is not what gets measured.
+type benchmarkComputer struct {
+	computer *Computer
+	inputs   []AddressValue
+	outputs  []AddressValue
+	halted   bool
+}
+
+func newBenchmarkComputer(program []AddressValue, profile Profile, inputs ...AddressValue) *benchmarkComputer {
+	computer := NewComputerWithProfile(program, profile)
+	computer.Input = make(chan AddressValue, 1)
+	computer.Output = make(chan AddressValue, 1)
+
+	return &benchmarkComputer{computer: computer, inputs: inputs}
+}
+
+// Run until the computer halts or wants an input that has not been sent yet.
+func (c *benchmarkComputer) run(b *testing.B) {
+	for !c.halted {
+		opcode, err := c.computer.NextOpcode()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		if opcode == INPUT {
+			if len(c.inputs) == 0 {
+				return
+			}
+
+			c.computer.Input <- c.inputs[0]
+			c.inputs = c.inputs[1:]
+		}
+
+		if _, err := c.computer.Step(); err != nil {
+			b.Fatal(err)
+		}
+
+		switch opcode {
+		case OUTPUT:
+			c.outputs = append(c.outputs, <-c.computer.Output)
+		case HALT:
+			c.halted = true
+		}
+	}
+}
+
+func BenchmarkDay02(b *testing.B) {
+	program := loadBenchmarkProgram(b, "02")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		computer := NewComputerWithProfile(program, Day2Profile)
+		computer.Memory.Set(1, 12)
+		computer.Memory.Set(2, 2)
+		computer.Run()
+	}
+}
+
+func benchmarkDay05(b *testing.B, systemID AddressValue) {
+	program := loadBenchmarkProgram(b, "05")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		newBenchmarkComputer(program, Day5Profile, systemID).run(b)
+	}
+}
+
+func BenchmarkDay05AirConditioner(b *testing.B) {
+	benchmarkDay05(b, 1)
+}
+
+func BenchmarkDay05ThermalRadiator(b *testing.B) {
+	benchmarkDay05(b, 5)
+}
+
+func benchmarkDay07(b *testing.B, phases []AddressValue) {
+	program := loadBenchmarkProgram(b, "07")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		amplifiers := make([]*benchmarkComputer, len(phases))
+		for j, phase := range phases {
+			amplifiers[j] = newBenchmarkComputer(program, Day5Profile, phase)
+		}
+
+		// Pass the signal around until the last amplifier halts, only once if there is no feedback
+		signal := AddressValue(0)
+		last := amplifiers[len(amplifiers)-1]
+
+		for !last.halted {
+			for _, amplifier := range amplifiers {
+				amplifier.inputs = append(amplifier.inputs, signal)
+				amplifier.run(b)
+
+				if len(amplifier.outputs) == 0 {
+					b.Fatal("amplifier did not send a signal")
+				}
+
+				signal = amplifier.outputs[len(amplifier.outputs)-1]
+				amplifier.outputs = amplifier.outputs[:0]
+			}
+		}
+	}
+}
+
+func BenchmarkDay07Chain(b *testing.B) {
+	benchmarkDay07(b, []AddressValue{4, 3, 2, 1, 0})
+}
+
+func BenchmarkDay07Feedback(b *testing.B) {
+	benchmarkDay07(b, []AddressValue{9, 8, 7, 6, 5})
+}
@@ -1,40 +1,71 @@
 package intcode
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
-	"github.com/rs/zerolog/log"
+	"github.com/giodamelio/aoc-2020-go/logging"
+	"github.com/rs/zerolog"
 )
 
 type Computer struct {
 	Memory             *Memory
 	instructionPointer AddressLocation
-	opcodes            map[AddressValue]Opcode
+	relativeBase       AddressLocation
+	instructionSet     *InstructionSet
 	errorHandler       func(error)
 	Input              chan AddressValue
 	Output             chan AddressValue
 	State              string
 	Name               string
+	overflowDetection  bool
+	ioTimeout          time.Duration
+	stuckPolicy        StuckPolicy
+	steps              int64
+	outputs            int64
+	policy             *Policy
+	code               *codeTracker
+	logger             zerolog.Logger
+	log                zerolog.Logger
+	opcodeLog          zerolog.Logger
 }
 
-func NewComputer(initialMemory []AddressValue) *Computer {
-	log.Debug().Msg("[COMPUTER] Computer created")
+// OverflowError is returned when arithmetic would wrap around with overflow detection on.
+type OverflowError struct {
+	InstructionPointer AddressLocation
+	Opcode             string
+	LeftHandSide       AddressValue
+	RightHandSide      AddressValue
+}
 
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf(
+		"integer overflow in %s at %d: %d and %d do not fit in 64 bits",
+		e.Opcode,
+		e.InstructionPointer,
+		e.LeftHandSide,
+		e.RightHandSide,
+	)
+}
+
+func NewComputer(initialMemory []AddressValue) *Computer {
 	copyOfInitialMemory := copyMemory(initialMemory)
 
 	comp := new(Computer)
 	comp.Memory = newMemory(copyOfInitialMemory)
 	comp.instructionPointer = 0
-	comp.opcodes = Opcodes
+	comp.instructionSet = DefaultInstructionSet()
 	comp.Input = make(chan AddressValue)
 	comp.Output = make(chan AddressValue)
 	comp.State = "pre-run"
 	comp.Name = "computer"
+	comp.SetLogger(zerolog.Nop())
 
 	// Default to panicing when things go wrong
 	comp.errorHandler = func(err error) {
-		log.Err(err).Msg("[COMPUTER] Uncaught error")
+		comp.log.Err(err).Msg("[COMPUTER] Uncaught error")
 		panic(err)
 	}
 
@@ -62,9 +93,9 @@ func (ic Computer) parseOpcode(rawOpcode AddressValue) (AddressValue, []Mode, er
 	}
 
 	// Check if it is a valid opcode
-	opcodeDefinition, ok := ic.opcodes[AddressValue(opcode)]
+	opcodeDefinition, ok := ic.instructionSet.Get(AddressValue(opcode))
 	if !ok {
-		err := fmt.Errorf("invalid opcode: %d", opcode)
+		err := ic.instructionSet.unavailableOpcodeError(AddressValue(opcode))
 
 		return 0, nil, err
 	}
@@ -82,6 +113,7 @@ func (ic Computer) parseOpcode(rawOpcode AddressValue) (AddressValue, []Mode, er
 	// Convert the mode numbers to their mode enum
 	// 0 = Position
 	// 1 = Immediate
+	// 2 = Relative
 	outputModes := make([]Mode, len(parameterModeSettings))
 
 	for i, m := range parameterModeSettings {
@@ -90,6 +122,10 @@ func (ic Computer) parseOpcode(rawOpcode AddressValue) (AddressValue, []Mode, er
 			return 0, nil, err
 		}
 
+		if !ic.instructionSet.AllowsMode(Mode(intM)) {
+			return 0, nil, ic.instructionSet.unavailableModeError(Mode(intM))
+		}
+
 		outputModes[i] = Mode(intM)
 	}
 
@@ -105,17 +141,29 @@ func (ic Computer) resolveParameters(
 	parameterModes []Mode,
 ) ([]AddressValue, error) {
 	resolvedParameters := make([]AddressValue, len(opcodeParameters))
+	opcodeDefinition, _ := ic.instructionSet.Get(opcode)
 
 	for index, opcodeParameter := range opcodeParameters {
-		parameterMode := ic.opcodes[opcode].Parameters[index]
+		parameterMode := opcodeDefinition.Parameters[index]
 
 		switch parameterModes[index] {
-		case Position:
+		case Position, Relative:
+			address := opcodeParameter
+			if parameterModes[index] == Relative {
+				address += AddressValue(ic.relativeBase)
+			}
+
+			if address < 0 {
+				err := fmt.Errorf("negative address: %d", address)
+
+				return nil, err
+			}
+
 			switch parameterMode {
 			case Write:
-				resolvedParameters[index] = opcodeParameter
+				resolvedParameters[index] = address
 			case Read:
-				resolvedParameters[index] = memory.Get(AddressLocation(opcodeParameter))
+				resolvedParameters[index] = memory.Get(AddressLocation(address))
 			default:
 				err := fmt.Errorf("invalid parameter mode: %d", parameterModes[index])
 
@@ -139,14 +187,86 @@ func (ic Computer) resolveParameters(
 	return resolvedParameters, nil
 }
 
+// SetInstructionSet changes the opcodes the computer understands.
+func (ic *Computer) SetInstructionSet(instructionSet *InstructionSet) {
+	ic.instructionSet = instructionSet
+}
+
+// InstructionSet gets the opcodes the computer understands.
+func (ic *Computer) InstructionSet() *InstructionSet {
+	return ic.instructionSet
+}
+
+// SetLogger sets where the computer logs to, by default it logs nothing.
+//
+// The computer, its memory and its opcodes each log through their own subsystem so their levels can be set separately.
+func (ic *Computer) SetLogger(logger zerolog.Logger) {
+	ic.logger = logger
+	ic.log = logging.Subsystem(logger, logging.Computer)
+	ic.opcodeLog = logging.Subsystem(logger, logging.Opcode)
+	ic.Memory.log = logging.Subsystem(logger, logging.Memory)
+}
+
+// Logger is the logger the computer was given.
+func (ic *Computer) Logger() zerolog.Logger {
+	return ic.logger
+}
+
+// SetOverflowDetection makes ADD and MULTIPLY fail with an OverflowError instead of wrapping around.
+func (ic *Computer) SetOverflowDetection(enabled bool) {
+	ic.overflowDetection = enabled
+}
+
+func (ic *Computer) OverflowDetection() bool {
+	return ic.overflowDetection
+}
+
 func (ic *Computer) SetInstructionPointer(address AddressLocation) {
 	ic.instructionPointer = address
 }
 
+func (ic *Computer) InstructionPointer() AddressLocation {
+	return ic.instructionPointer
+}
+
+// RelativeBase is the address relative mode parameters are offset from.
+func (ic *Computer) RelativeBase() AddressLocation {
+	return ic.relativeBase
+}
+
+// Steps is how many instructions the interpreter has finished running.
+func (ic *Computer) Steps() int64 {
+	return ic.steps
+}
+
+// NextOpcode decodes the opcode the next Step will execute without running it.
+func (ic *Computer) NextOpcode() (AddressValue, error) {
+	if ic.instructionPointer < 0 {
+		return -1, fmt.Errorf("negative instruction pointer: %d", ic.instructionPointer)
+	}
+
+	opcode, _, err := ic.parseOpcode(ic.Memory.Get(ic.instructionPointer))
+	if err != nil {
+		return -1, err
+	}
+
+	return opcode, nil
+}
+
 func (ic *Computer) Step() (AddressValue, error) {
+	address := ic.instructionPointer
+
+	if err := ic.policy.checkStep(ic); err != nil {
+		return -1, err
+	}
+
+	if address < 0 {
+		return -1, fmt.Errorf("negative instruction pointer: %d", address)
+	}
+
 	// Get the opcode at the address of the instruction pointer
 	opcode := ic.Memory.Get(ic.instructionPointer)
-	log.Trace().Int64("opcode", int64(opcode)).Msg("[COMPUTER] Retrieved opcode")
+	ic.log.Trace().Int64("opcode", int64(opcode)).Msg("[COMPUTER] Retrieved opcode")
 
 	// Parse the opcode
 	opcode, parameterModes, err := ic.parseOpcode(opcode)
@@ -154,16 +274,26 @@ func (ic *Computer) Step() (AddressValue, error) {
 		return -1, err
 	}
 
-	log.
+	if opcode == OUTPUT {
+		if err := ic.policy.checkOutput(ic); err != nil {
+			return -1, err
+		}
+	}
+
+	ic.log.
 		Trace().
 		Int64("opcode", int64(opcode)).
 		Msg("[COMPUTER] Parsed opcode")
 
+	operation, _ := ic.instructionSet.Get(opcode)
+
 	// Get the parameters for the opcode
-	parametersLength := len(ic.opcodes[opcode].Parameters)
+	parametersLength := len(operation.Parameters)
 	opcodeParameters := ic.Memory.GetRange(ic.instructionPointer+1, int64(parametersLength))
 	// TO DO: fix this log
-	// log.Trace().Ints("parameters", opcodeParameters).Msg("[COMPUTER] Retrieved opcode parameters")
+	// ic.log.Trace().Ints("parameters", opcodeParameters).Msg("[COMPUTER] Retrieved opcode parameters")
+
+	ic.code.execute(ic.instructionPointer, operation.Length())
 
 	// Resolve the parameters based on the modes
 	opcodeParameters, err = ic.resolveParameters(ic.Memory, opcode, opcodeParameters, parameterModes)
@@ -172,12 +302,27 @@ func (ic *Computer) Step() (AddressValue, error) {
 	}
 
 	// TO DO: fix this log
-	// log.Trace().Ints("parameters", opcodeParameters).Msg("[COMPUTER] Resolved opcode parameters")
+	// ic.log.Trace().Ints("parameters", opcodeParameters).Msg("[COMPUTER] Resolved opcode parameters")
 
 	// Execute the opcode
-	operation := ic.opcodes[opcode]
-	log.Trace().Str("opcodeName", operation.Name).Msg("[COMPUTER] Executing operation")
-	operation.execute(ic, operation, opcodeParameters)
+	ic.log.Trace().Str("opcodeName", operation.Name).Msg("[COMPUTER] Executing operation")
+
+	err = operation.Execute(ic, operation, opcodeParameters)
+	if err != nil {
+		// Memory does not know which instruction made a write it refused
+		var violation *PolicyViolation
+		if errors.As(err, &violation) {
+			violation.InstructionPointer = address
+		}
+
+		return -1, err
+	}
+
+	ic.steps++
+
+	if opcode == OUTPUT {
+		ic.outputs++
+	}
 
 	return opcode, nil
 }
@@ -187,20 +332,33 @@ func (ic *Computer) Run() {
 
 	for {
 		opcode, err := ic.Step()
+
+		// Nothing came to read, so stop the computer instead of leaving it blocked
+		if errors.Is(err, errInputDropped) {
+			ic.Halt()
+
+			break
+		}
+
 		if err != nil {
 			ic.errorHandler(err)
 		}
 
 		// Special case for HALT
 		if opcode == HALT {
-			ic.State = "halted"
-
-			log.Info().Str("name", ic.Name).Msg("[COMPUTER] Halt")
-
-			close(ic.Input)
-			close(ic.Output)
+			ic.Halt()
 
 			break
 		}
 	}
 }
+
+// Halt marks the computer as halted and closes its channels.
+func (ic *Computer) Halt() {
+	ic.State = "halted"
+
+	ic.log.Info().Str("name", ic.Name).Msg("[COMPUTER] Halt")
+
+	close(ic.Input)
+	close(ic.Output)
+}
@@ -1,23 +1,17 @@
 package intcode
 
 import (
-	"os"
+	"bytes"
+	"errors"
+	"strings"
 	"testing"
 
+	"github.com/giodamelio/aoc-2020-go/logging"
+	"github.com/giodamelio/aoc-2020-go/logging/loggingtest"
 	"github.com/rs/zerolog"
-	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
 )
 
-func init() {
-	out := zerolog.NewConsoleWriter()
-	out.Out = os.Stderr
-	out.NoColor = true
-	log.Logger = log.Output(out)
-
-	zerolog.SetGlobalLevel(zerolog.TraceLevel)
-}
-
 func TestNewComputer(t *testing.T) {
 	computer := NewComputer([]AddressValue{1, 2, 3})
 
@@ -51,7 +45,9 @@ func TestParseOpcode(t *testing.T) {
 		opcode, parameterModes, err := computer.parseOpcode(opcode)
 
 		assert.Nil(t, err)
-		assert.Equal(t, len(computer.opcodes[opcode].Parameters), len(parameterModes))
+		definition, ok := computer.InstructionSet().Get(opcode)
+		assert.True(t, ok)
+		assert.Equal(t, len(definition.Parameters), len(parameterModes))
 	}
 }
 
@@ -100,18 +96,20 @@ func TestResolveParametersErrors(t *testing.T) {
 		computer.Memory,
 		2,
 		[]AddressValue{11, 11, 0},
-		[]Mode{Immediate, Immediate, 2},
+		[]Mode{Immediate, Immediate, 3},
 	)
 
-	assert.Equal(t, "invalid mode: 2", err.Error())
+	assert.Equal(t, "invalid mode: 3", err.Error())
 	assert.Nil(t, opcodeParameters)
 
 	// Create new opcode with bad parameter mode
-	computer.opcodes[98] = Opcode{
+	computer.instructionSet.opcodes[98] = Opcode{
 		Name:       "FAKE",
 		Opcode:     98,
 		Parameters: []ReadWrite{Read, Read, 2},
-		execute:    func(computer *Computer, operation Opcode, parameters []AddressValue) {},
+		Execute: func(computer *Computer, operation Opcode, parameters []AddressValue) error {
+			return nil
+		},
 	}
 	opcodeParameters, err = computer.resolveParameters(
 		computer.Memory,
@@ -124,6 +122,30 @@ func TestResolveParametersErrors(t *testing.T) {
 	assert.Nil(t, opcodeParameters)
 }
 
+func TestResolveParametersRelative(t *testing.T) {
+	computer := NewComputer([]AddressValue{22201, 1, 2, 3, 99, 10, 20})
+	computer.relativeBase = 4
+
+	opcodeParameters, err := computer.resolveParameters(
+		computer.Memory,
+		1,
+		[]AddressValue{1, 2, 3},
+		[]Mode{Relative, Relative, Relative},
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []AddressValue{10, 20, 7}, opcodeParameters)
+
+	_, err = computer.resolveParameters(
+		computer.Memory,
+		1,
+		[]AddressValue{-5, 2, 3},
+		[]Mode{Relative, Relative, Relative},
+	)
+
+	assert.Equal(t, "negative address: -1", err.Error())
+}
+
 func TestSetInstructionPointer(t *testing.T) {
 	computer := NewComputer([]AddressValue{1, 0, 0, 0})
 
@@ -134,6 +156,36 @@ func TestSetInstructionPointer(t *testing.T) {
 	assert.Equal(t, AddressLocation(3), computer.instructionPointer)
 }
 
+func TestInstructionPointer(t *testing.T) {
+	computer := NewComputer([]AddressValue{1, 0, 0, 0})
+
+	computer.SetInstructionPointer(2)
+
+	assert.Equal(t, AddressLocation(2), computer.InstructionPointer())
+}
+
+func TestNextOpcode(t *testing.T) {
+	computer := NewComputer([]AddressValue{1101, 50, 1, 0, 3, 0, 99})
+
+	opcode, err := computer.NextOpcode()
+	assert.Nil(t, err)
+	assert.Equal(t, AddressValue(ADD), opcode)
+
+	// Peeking does not run anything
+	assert.Equal(t, AddressLocation(0), computer.instructionPointer)
+
+	computer.SetInstructionPointer(4)
+
+	opcode, err = computer.NextOpcode()
+	assert.Nil(t, err)
+	assert.Equal(t, AddressValue(INPUT), opcode)
+
+	computer.SetInstructionPointer(1)
+
+	_, err = computer.NextOpcode()
+	assert.Equal(t, "invalid opcode: 50", err.Error())
+}
+
 func TestStep(t *testing.T) {
 	computer := NewComputer([]AddressValue{1, 0, 0, 0})
 
@@ -142,6 +194,7 @@ func TestStep(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, AddressValue(1), opcode)
 	assert.Equal(t, []AddressValue{2, 0, 0, 0}, computer.Memory.rawMemory)
+	assert.Equal(t, int64(1), computer.Steps())
 }
 
 func TestStepInvalidOpcode(t *testing.T) {
@@ -154,6 +207,23 @@ func TestStepInvalidOpcode(t *testing.T) {
 	assert.Equal(t, []AddressValue{-1, 0, 0, 0}, computer.Memory.rawMemory)
 }
 
+func TestStepHandlerError(t *testing.T) {
+	computer := NewComputer([]AddressValue{50, 99})
+	assert.Nil(t, computer.InstructionSet().Register(Opcode{
+		Name:       "FAIL",
+		Opcode:     50,
+		Parameters: []ReadWrite{},
+		Execute: func(computer *Computer, operation Opcode, parameters []AddressValue) error {
+			return errors.New("something went wrong")
+		},
+	}))
+
+	opcode, err := computer.Step()
+
+	assert.Equal(t, AddressValue(-1), opcode)
+	assert.Equal(t, "something went wrong", err.Error())
+}
+
 func TestStepNoIncrementInstructionPointer(t *testing.T) {
 	computer := NewComputer([]AddressValue{99})
 
@@ -173,6 +243,27 @@ func TestRun(t *testing.T) {
 	assert.Equal(t, AddressValue(3500), computer.Memory.rawMemory[0])
 }
 
+func TestRunRelativeMode(t *testing.T) {
+	// Move the base to 10 then add the values just past the end of the program into it
+	computer := NewComputer([]AddressValue{109, 10, 21101, 5, 6, 2, 99})
+
+	computer.Run()
+
+	assert.Equal(t, AddressLocation(10), computer.RelativeBase())
+	assert.Equal(t, AddressValue(11), computer.Memory.Get(12))
+}
+
+func TestStepNegativeInstructionPointer(t *testing.T) {
+	computer := NewComputer([]AddressValue{99})
+	computer.SetInstructionPointer(-1)
+
+	_, err := computer.Step()
+	assert.Equal(t, "negative instruction pointer: -1", err.Error())
+
+	_, err = computer.NextOpcode()
+	assert.Equal(t, "negative instruction pointer: -1", err.Error())
+}
+
 func TestRunInvalidOpcode(t *testing.T) {
 	computer := NewComputer([]AddressValue{-1})
 
@@ -181,6 +272,46 @@ func TestRunInvalidOpcode(t *testing.T) {
 	})
 }
 
+func TestSetLogger(t *testing.T) {
+	logging.SetSubsystemLevels(map[string]zerolog.Level{logging.Memory: zerolog.WarnLevel})
+	defer logging.SetSubsystemLevels(nil)
+
+	var out bytes.Buffer
+
+	computer := NewComputer([]AddressValue{1101, 1, 2, 0, 99})
+	computer.SetLogger(zerolog.New(&out).Level(zerolog.DebugLevel))
+
+	computer.Run()
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(t, []string{
+		`{"level":"debug","subsystem":"opcode","leftHandSide":1,"rightHandSide":2,"result":3,"message":"[OPCODE] ADD"}`,
+		`{"level":"debug","subsystem":"opcode","message":"[OPCODE] HALT"}`,
+		`{"level":"info","subsystem":"computer","name":"computer","message":"[COMPUTER] Halt"}`,
+	}, lines)
+}
+
+func TestComputerHalt(t *testing.T) {
+	computer := NewComputer([]AddressValue{99})
+
+	computer.Halt()
+
+	assert.Equal(t, "halted", computer.State)
+
+	_, open := <-computer.Output
+	assert.False(t, open)
+}
+
+func TestRunOverflow(t *testing.T) {
+	computer := NewComputer([]AddressValue{1101, 0, 0, 11, 1102, 1 << 40, 1 << 40, 11, 99, 0, 0, 0})
+	computer.SetOverflowDetection(true)
+
+	assert.True(t, computer.OverflowDetection())
+	assert.PanicsWithError(t, "integer overflow in MULTIPLY at 4: 1099511627776 and 1099511627776 do not fit in 64 bits", func() {
+		computer.Run()
+	})
+}
+
 func TestInputChannel(t *testing.T) {
 	computer := NewComputer([]AddressValue{3, 3, 99, 0})
 
@@ -224,6 +355,7 @@ func TestOutputChannel(t *testing.T) {
 // Add two numbers.
 func TestAddTwoNumber(t *testing.T) {
 	computer := NewComputer([]AddressValue{1101, 11, 22, 0, 99})
+	computer.SetLogger(loggingtest.New(t))
 
 	computer.Run()
 
@@ -237,6 +369,7 @@ func TestAddTwoNumber(t *testing.T) {
 // Take an input, double it and output it.
 func TestDoubleInput(t *testing.T) {
 	computer := NewComputer([]AddressValue{3, 0, 2, 2, 0, 0, 4, 0, 99})
+	computer.SetLogger(loggingtest.New(t))
 
 	// Number to be doubled
 	sendInput := func() {
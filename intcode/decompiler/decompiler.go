@@ -0,0 +1,451 @@
+package decompiler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/intcode/analysis"
+)
+
+type loop struct {
+	header intcode.AddressLocation
+	body   map[intcode.AddressLocation]bool
+	// Where control goes when the loop finishes, exitNode if it never does
+	exit intcode.AddressLocation
+	// The block holding the only back edge to the header
+	latch   intcode.AddressLocation
+	doWhile bool
+	// The condition to repeat a do while loop, filled in when the latch is emitted
+	condition string
+}
+
+type line struct {
+	indent int
+	text   string
+}
+
+type decompiler struct {
+	graph   *analysis.Graph
+	idom    map[intcode.AddressLocation]intcode.AddressLocation
+	ipdom   map[intcode.AddressLocation]intcode.AddressLocation
+	loops   map[intcode.AddressLocation]*loop
+	emitted map[intcode.AddressLocation]bool
+	// Blocks that are the target of a goto and need a label
+	labels map[intcode.AddressLocation]bool
+	// The line each block starts on
+	anchors map[intcode.AddressLocation]int
+	lines   []line
+}
+
+// Decompile an intcode program starting from address 0 into structured pseudocode.
+func Decompile(program []intcode.AddressValue) string {
+	return DecompileGraph(analysis.Analyze(program))
+}
+
+// DecompileGraph turns an already analyzed program into structured pseudocode.
+//
+// Every function the program calls is written out after main.
+func DecompileGraph(graph *analysis.Graph) string {
+	flow := newFlowGraph(graph)
+
+	d := &decompiler{
+		graph:   graph,
+		idom:    make(map[intcode.AddressLocation]intcode.AddressLocation),
+		ipdom:   flow.reverse().dominators(exitNode),
+		emitted: make(map[intcode.AddressLocation]bool),
+		labels:  make(map[intcode.AddressLocation]bool),
+		anchors: make(map[intcode.AddressLocation]int),
+	}
+
+	entries := []intcode.AddressLocation{graph.Entry}
+
+	for _, function := range graph.Functions {
+		if function != graph.Entry {
+			entries = append(entries, function)
+		}
+	}
+
+	// Main goes last so it wins for any code a function shares with it
+	for i := len(entries) - 1; i >= 0; i-- {
+		for node, dominator := range flow.dominators(entries[i]) {
+			d.idom[node] = dominator
+		}
+	}
+
+	d.loops = d.findLoops(flow)
+
+	for i, entry := range entries {
+		if i == 0 {
+			d.line(0, "func main() {")
+		} else {
+			d.line(0, "")
+			d.line(0, "func %s() {", functionName(entry))
+		}
+
+		d.region(entry, exitNode, nil, 1)
+
+		reachable := make(map[intcode.AddressLocation]bool)
+		for _, node := range flow.reversePostorder(entry) {
+			reachable[node] = true
+		}
+
+		// Anything we could not reach in a structured way is only reachable with a goto
+		for _, block := range graph.Blocks {
+			if reachable[block.Start] && !d.emitted[block.Start] && d.labels[block.Start] {
+				d.region(block.Start, exitNode, nil, 1)
+			}
+		}
+
+		d.line(0, "}")
+	}
+
+	return d.render()
+}
+
+func functionName(address intcode.AddressLocation) string {
+	return fmt.Sprintf("f%d", address)
+}
+
+// Find the natural loop of every back edge, merging loops that share a header.
+func (d *decompiler) findLoops(flow *flowGraph) map[intcode.AddressLocation]*loop {
+	loops := make(map[intcode.AddressLocation]*loop)
+	latches := make(map[intcode.AddressLocation][]intcode.AddressLocation)
+
+	for _, node := range flow.nodes {
+		for _, successor := range flow.successors[node] {
+			if !dominates(d.idom, successor, node) {
+				continue
+			}
+
+			header := successor
+			latches[header] = append(latches[header], node)
+
+			l, ok := loops[header]
+			if !ok {
+				l = &loop{header: header, body: map[intcode.AddressLocation]bool{header: true}}
+				loops[header] = l
+			}
+
+			// Everything that reaches the latch without passing through the header is in the loop
+			worklist := []intcode.AddressLocation{node}
+			for len(worklist) > 0 {
+				current := worklist[len(worklist)-1]
+				worklist = worklist[:len(worklist)-1]
+
+				if l.body[current] {
+					continue
+				}
+
+				l.body[current] = true
+				worklist = append(worklist, flow.predecessors[current]...)
+			}
+		}
+	}
+
+	for header, l := range loops {
+		var exits []intcode.AddressLocation
+
+		for node := range l.body {
+			for _, successor := range flow.successors[node] {
+				if !l.body[successor] {
+					exits = append(exits, successor)
+				}
+			}
+		}
+
+		sort.Slice(exits, func(i, j int) bool {
+			return exits[i] < exits[j]
+		})
+
+		l.exit = exitNode
+		if len(exits) > 0 {
+			l.exit = exits[0]
+		}
+
+		if len(latches[header]) == 1 {
+			l.latch = latches[header][0]
+		} else {
+			l.latch = exitNode
+		}
+	}
+
+	return loops
+}
+
+func (d *decompiler) line(indent int, format string, args ...interface{}) {
+	d.lines = append(d.lines, line{indent: indent, text: fmt.Sprintf(format, args...)})
+}
+
+// Remember where a block starts so a label can be placed there if anything jumps to it.
+func (d *decompiler) anchor(address intcode.AddressLocation) {
+	d.emitted[address] = true
+	d.anchors[address] = len(d.lines)
+}
+
+func (d *decompiler) gotoLine(indent int, target intcode.AddressLocation) {
+	d.labels[target] = true
+	d.line(indent, "goto L%d", target)
+}
+
+// How to get from inside a loop to target without falling through, if it needs anything special.
+func (d *decompiler) escape(target intcode.AddressLocation, current *loop) string {
+	if current == nil {
+		return ""
+	}
+
+	if target == current.header {
+		return "continue"
+	}
+
+	if current.body[target] {
+		return ""
+	}
+
+	if target == current.exit {
+		return "break"
+	}
+
+	d.labels[target] = true
+
+	return fmt.Sprintf("goto L%d", target)
+}
+
+// Emit everything from start until control reaches stop.
+func (d *decompiler) region(start intcode.AddressLocation, stop intcode.AddressLocation, current *loop, indent int) {
+	for cur := start; cur != stop && cur != exitNode; {
+		if current != nil && (cur == current.header || !current.body[cur]) {
+			d.line(indent, "%s", d.escape(cur, current))
+
+			return
+		}
+
+		if d.emitted[cur] {
+			d.gotoLine(indent, cur)
+
+			return
+		}
+
+		if _, ok := d.graph.Block(cur); !ok {
+			d.line(indent, "fault(%q)", d.graph.Invalid[cur])
+
+			return
+		}
+
+		if l, isHeader := d.loops[cur]; isHeader {
+			d.emitLoop(l, indent)
+			cur = l.exit
+
+			continue
+		}
+
+		cur = d.emitBlock(cur, current, indent)
+	}
+}
+
+// Emit the statements of a block and return where control goes next.
+func (d *decompiler) emitBlock(address intcode.AddressLocation, current *loop, indent int) intcode.AddressLocation {
+	block, _ := d.graph.Block(address)
+	d.anchor(address)
+
+	last := block.Last()
+
+	for index, instruction := range block.Instructions {
+		if instruction.IsJump() {
+			break
+		}
+
+		// Storing the return address is part of the call
+		if block.Call && index == len(block.Instructions)-2 {
+			break
+		}
+
+		d.line(indent, "%s", statement(instruction))
+	}
+
+	if block.Call {
+		d.line(indent, "call %s()", functionName(block.Successors[0].To))
+
+		return block.Successors[1].To
+	}
+
+	if block.Return {
+		if _, known := last.StaticCondition(); known {
+			d.line(indent, "return")
+
+			return exitNode
+		}
+
+		d.line(indent, "if %s {", condition(last, false))
+		d.line(indent+1, "return")
+		d.line(indent, "}")
+	}
+
+	if block.Indirect {
+		target := operand(last, 1)
+
+		if _, known := last.StaticCondition(); known {
+			d.line(indent, "goto *%s", target)
+
+			return exitNode
+		}
+
+		d.line(indent, "if %s {", condition(last, false))
+		d.line(indent+1, "goto *%s", target)
+		d.line(indent, "}")
+	}
+
+	switch len(block.Successors) {
+	case 0:
+		return exitNode
+	case 1:
+		return block.Successors[0].To
+	}
+
+	return d.emitIf(block, current, indent)
+}
+
+// Emit a two way branch at the end of a block and return where both sides meet again.
+func (d *decompiler) emitIf(block *analysis.BasicBlock, current *loop, indent int) intcode.AddressLocation {
+	last := block.Last()
+	taken := block.Successors[0].To
+	fall := block.Successors[1].To
+
+	if taken == fall {
+		return taken
+	}
+
+	// The latch of a do while loop turns into the loop condition
+	if current != nil && current.doWhile && block.Start == current.latch {
+		current.condition = condition(last, fall == current.header)
+
+		return current.header
+	}
+
+	// Branches that leave or restart a loop become a guarded break or continue
+	if escape := d.escape(taken, current); escape != "" {
+		d.line(indent, "if %s {", condition(last, false))
+		d.line(indent+1, "%s", escape)
+		d.line(indent, "}")
+
+		return fall
+	}
+
+	if escape := d.escape(fall, current); escape != "" {
+		d.line(indent, "if %s {", condition(last, true))
+		d.line(indent+1, "%s", escape)
+		d.line(indent, "}")
+
+		return taken
+	}
+
+	merge, ok := d.ipdom[block.Start]
+	if !ok {
+		merge = exitNode
+	}
+
+	switch merge {
+	case taken:
+		d.line(indent, "if %s {", condition(last, true))
+		d.region(fall, merge, current, indent+1)
+	case fall:
+		d.line(indent, "if %s {", condition(last, false))
+		d.region(taken, merge, current, indent+1)
+	default:
+		d.line(indent, "if %s {", condition(last, false))
+		d.region(taken, merge, current, indent+1)
+		d.line(indent, "} else {")
+		d.region(fall, merge, current, indent+1)
+	}
+
+	d.line(indent, "}")
+
+	return merge
+}
+
+// Emit a loop as a while, do while or an endless loop with breaks.
+func (d *decompiler) emitLoop(l *loop, indent int) {
+	header, _ := d.graph.Block(l.header)
+	last := header.Last()
+
+	// A header that is only a conditional jump out of the loop is a while loop
+	if len(header.Instructions) == 1 && len(header.Successors) == 2 && !header.Call {
+		taken := header.Successors[0].To
+		fall := header.Successors[1].To
+
+		if l.body[taken] != l.body[fall] {
+			inside, negate := taken, false
+			if l.body[fall] {
+				inside, negate = fall, true
+			}
+
+			d.anchor(l.header)
+			d.line(indent, "while %s {", condition(last, negate))
+			d.region(inside, l.header, l, indent+1)
+			d.line(indent, "}")
+
+			return
+		}
+	}
+
+	// A single latch that decides between repeating and leaving is a do while loop
+	if l.latch != exitNode {
+		latch, _ := d.graph.Block(l.latch)
+		successors := latch.Successors
+
+		if len(successors) == 2 && !latch.Indirect && !latch.Call &&
+			(successors[0].To == l.header || successors[1].To == l.header) &&
+			(successors[0].To == l.exit || successors[1].To == l.exit) {
+			l.doWhile = true
+
+			opener := len(d.lines)
+			d.line(indent, "do {")
+			next := d.emitBlock(l.header, l, indent+1)
+			d.anchors[l.header] = opener
+			d.region(next, l.header, l, indent+1)
+			d.line(indent, "} while %s", l.condition)
+
+			return
+		}
+	}
+
+	opener := len(d.lines)
+	d.line(indent, "loop {")
+	next := d.emitBlock(l.header, l, indent+1)
+	d.anchors[l.header] = opener
+	d.region(next, l.header, l, indent+1)
+	d.line(indent, "}")
+}
+
+// Turn the collected lines into text, placing labels in front of every goto target.
+func (d *decompiler) render() string {
+	labelsAt := make(map[int][]intcode.AddressLocation)
+
+	for address := range d.labels {
+		if index, ok := d.anchors[address]; ok {
+			labelsAt[index] = append(labelsAt[index], address)
+		}
+	}
+
+	var out strings.Builder
+
+	for _, finding := range d.graph.Findings {
+		fmt.Fprintf(&out, "// warning: %s\n", finding)
+	}
+
+	for index, l := range d.lines {
+		labels := labelsAt[index]
+		sort.Slice(labels, func(i, j int) bool {
+			return labels[i] < labels[j]
+		})
+
+		for _, label := range labels {
+			fmt.Fprintf(&out, "%sL%d:\n", strings.Repeat("\t", l.indent-1), label)
+		}
+
+		fmt.Fprintf(&out, "%s%s\n", strings.Repeat("\t", l.indent), l.text)
+	}
+
+	return out.String()
+}
@@ -0,0 +1,256 @@
+package decompiler
+
+import (
+	"testing"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/intcode/assembler"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecompileStraightLine(t *testing.T) {
+	output := Decompile(assembler.Assemble(`
+	INPUT	9
+	MULTIPLY	9	i2	9
+	OUTPUT	9
+	HALT
+	`))
+
+	assert.Equal(t, `func main() {
+	m[9] = input()
+	m[9] = m[9] * 2
+	output(m[9])
+	halt()
+}
+`, output)
+}
+
+func TestDecompileRelative(t *testing.T) {
+	output := Decompile(assembler.Assemble(`
+	ADJUST-RELATIVE-BASE	i10
+	INPUT	r0
+	ADD	r0	r-3	r1
+	ADJUST-RELATIVE-BASE	i-4
+	OUTPUT	r5
+	HALT
+	`))
+
+	assert.Equal(t, `func main() {
+	rb += 10
+	m[rb+0] = input()
+	m[rb+1] = m[rb+0] + m[rb-3]
+	rb -= 4
+	output(m[rb+5])
+	halt()
+}
+`, output)
+}
+
+func TestDecompileIf(t *testing.T) {
+	// Output 1 if the input is not zero
+	output := Decompile(assembler.Assemble(`
+	INPUT		12
+	JUMP-IF-FALSE	12	i7
+	OUTPUT	i1
+	HALT
+	`))
+
+	assert.Equal(t, `func main() {
+	m[12] = input()
+	if m[12] != 0 {
+		output(1)
+	}
+	halt()
+}
+`, output)
+}
+
+func TestDecompileIfElse(t *testing.T) {
+	output := Decompile(assembler.Assemble(`
+	INPUT		20
+	JUMP-IF-TRUE	20	i10
+	OUTPUT	i0
+	JUMP-IF-TRUE	i1	i12
+	OUTPUT	i1
+	HALT
+	`))
+
+	assert.Equal(t, `func main() {
+	m[20] = input()
+	if m[20] != 0 {
+		output(1)
+	} else {
+		output(0)
+	}
+	halt()
+}
+`, output)
+}
+
+func TestDecompileWhile(t *testing.T) {
+	// Output every number from the input down to one
+	output := Decompile(assembler.Assemble(`
+	INPUT		20
+	JUMP-IF-FALSE	20	i14
+	OUTPUT	20
+	ADD		20	i-1	20
+	JUMP-IF-TRUE	i1	i2
+	HALT
+	`))
+
+	assert.Equal(t, `func main() {
+	m[20] = input()
+	while m[20] != 0 {
+		output(m[20])
+		m[20] = m[20] - 1
+	}
+	halt()
+}
+`, output)
+}
+
+func TestDecompileDoWhile(t *testing.T) {
+	output := Decompile(assembler.Assemble(`
+	INPUT		20
+	OUTPUT	20
+	ADD		20	i-1	20
+	JUMP-IF-TRUE	20	i2
+	HALT
+	`))
+
+	assert.Equal(t, `func main() {
+	m[20] = input()
+	do {
+		output(m[20])
+		m[20] = m[20] - 1
+	} while m[20] != 0
+	halt()
+}
+`, output)
+}
+
+func TestDecompileBreak(t *testing.T) {
+	// Echo inputs until one of them is zero
+	output := Decompile(assembler.Assemble(`
+	INPUT		20
+	JUMP-IF-FALSE	20	i10
+	OUTPUT	20
+	JUMP-IF-TRUE	i1	i0
+	OUTPUT	i-1
+	HALT
+	`))
+
+	assert.Equal(t, `func main() {
+	loop {
+		m[20] = input()
+		if m[20] == 0 {
+			break
+		}
+		output(m[20])
+	}
+	output(-1)
+	halt()
+}
+`, output)
+}
+
+func TestDecompileWarnings(t *testing.T) {
+	output := Decompile(assembler.Assemble(`
+	JUMP-IF-TRUE	i1	4
+	HALT
+	DATA	3
+	`))
+
+	assert.Equal(t, `// warning: 0: indirect-jump: JUMP-IF-TRUE target is read from address 4
+func main() {
+	goto *m[4]
+}
+`, output)
+}
+
+func TestDecompileRelativeIndirectJump(t *testing.T) {
+	output := Decompile(assembler.Assemble(`
+	INPUT	20
+	JUMP-IF-TRUE	20	r2
+	HALT
+	`))
+
+	assert.Equal(t, `// warning: 2: indirect-jump: JUMP-IF-TRUE target is read from the relative base plus 2
+func main() {
+	m[20] = input()
+	if m[20] != 0 {
+		goto *m[rb+2]
+	}
+	halt()
+}
+`, output)
+}
+
+func TestDecompileStoreBeforeJumpIsKept(t *testing.T) {
+	// The OUTPUT right before the jump overlaps something that decodes like a return address store
+	output := Decompile([]intcode.AddressValue{1105, 1, 8, 0, 0, 0, 21101, -91, 104, 5, 1105, 1, 14, 99, 99})
+
+	assert.Equal(t, `func main() {
+	output(5)
+	halt()
+}
+`, output)
+}
+
+func TestDecompileConditionalReturn(t *testing.T) {
+	output := Decompile(assembler.Assemble(`
+	ADD	i0	i7	r0
+	JUMP-IF-TRUE	i1	i8
+	HALT
+	INPUT	20
+	JUMP-IF-TRUE	20	r0
+	OUTPUT	20
+	JUMP-IF-TRUE	i1	r0
+	`))
+
+	assert.Equal(t, `func main() {
+	call f8()
+	halt()
+}
+
+func f8() {
+	m[20] = input()
+	if m[20] != 0 {
+		return
+	}
+	output(m[20])
+	return
+}
+`, output)
+}
+
+func TestDecompileCall(t *testing.T) {
+	// Call a function that sets m[50] twice, outputting it after each call
+	output := Decompile(assembler.Assemble(`
+	ADJUST-RELATIVE-BASE	i100
+	ADD	i0	i9	r0
+	JUMP-IF-TRUE	i1	i21
+	OUTPUT	50
+	MULTIPLY	i1	i18	r0
+	JUMP-IF-FALSE	i0	i21
+	OUTPUT	50
+	HALT
+	ADD	i0	i7	50
+	JUMP-IF-FALSE	i0	r0
+	`))
+
+	assert.Equal(t, `func main() {
+	rb += 100
+	call f21()
+	output(m[50])
+	call f21()
+	output(m[50])
+	halt()
+}
+
+func f21() {
+	m[50] = 0 + 7
+	return
+}
+`, output)
+}
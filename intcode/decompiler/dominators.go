@@ -0,0 +1,167 @@
+package decompiler
+
+import (
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/intcode/analysis"
+)
+
+// Stands in for every way out of the program when computing post dominators.
+const exitNode intcode.AddressLocation = -1
+
+// A directed graph of block start addresses.
+type flowGraph struct {
+	nodes        []intcode.AddressLocation
+	successors   map[intcode.AddressLocation][]intcode.AddressLocation
+	predecessors map[intcode.AddressLocation][]intcode.AddressLocation
+}
+
+func newFlowGraph(graph *analysis.Graph) *flowGraph {
+	flow := &flowGraph{
+		successors:   make(map[intcode.AddressLocation][]intcode.AddressLocation),
+		predecessors: make(map[intcode.AddressLocation][]intcode.AddressLocation),
+	}
+
+	for _, block := range graph.Blocks {
+		flow.nodes = append(flow.nodes, block.Start)
+	}
+
+	// Functions get their own entry, a call only continues at the return address
+	for _, block := range graph.Blocks {
+		for _, edge := range block.Successors {
+			if _, ok := graph.Block(edge.To); !ok || edge.Kind == analysis.Call {
+				continue
+			}
+
+			flow.successors[block.Start] = append(flow.successors[block.Start], edge.To)
+			flow.predecessors[edge.To] = append(flow.predecessors[edge.To], block.Start)
+		}
+	}
+
+	return flow
+}
+
+// Flip every edge and route every block without successors to the exit node.
+func (f *flowGraph) reverse() *flowGraph {
+	reversed := &flowGraph{
+		nodes:        append([]intcode.AddressLocation{exitNode}, f.nodes...),
+		successors:   make(map[intcode.AddressLocation][]intcode.AddressLocation),
+		predecessors: make(map[intcode.AddressLocation][]intcode.AddressLocation),
+	}
+
+	for _, node := range f.nodes {
+		if len(f.successors[node]) == 0 {
+			reversed.successors[exitNode] = append(reversed.successors[exitNode], node)
+			reversed.predecessors[node] = append(reversed.predecessors[node], exitNode)
+		}
+
+		for _, successor := range f.successors[node] {
+			reversed.successors[successor] = append(reversed.successors[successor], node)
+			reversed.predecessors[node] = append(reversed.predecessors[node], successor)
+		}
+	}
+
+	return reversed
+}
+
+// Order the nodes reachable from entry so every node comes before its successors, ignoring back edges.
+func (f *flowGraph) reversePostorder(entry intcode.AddressLocation) []intcode.AddressLocation {
+	visited := make(map[intcode.AddressLocation]bool)
+
+	var postorder []intcode.AddressLocation
+
+	var visit func(node intcode.AddressLocation)
+	visit = func(node intcode.AddressLocation) {
+		visited[node] = true
+
+		for _, successor := range f.successors[node] {
+			if !visited[successor] {
+				visit(successor)
+			}
+		}
+
+		postorder = append(postorder, node)
+	}
+	visit(entry)
+
+	for i, j := 0, len(postorder)-1; i < j; i, j = i+1, j-1 {
+		postorder[i], postorder[j] = postorder[j], postorder[i]
+	}
+
+	return postorder
+}
+
+// Compute the immediate dominator of every node reachable from entry.
+//
+// This is the iterative algorithm from "A Simple, Fast Dominance Algorithm" by Cooper, Harvey and Kennedy.
+func (f *flowGraph) dominators(entry intcode.AddressLocation) map[intcode.AddressLocation]intcode.AddressLocation {
+	order := f.reversePostorder(entry)
+
+	index := make(map[intcode.AddressLocation]int, len(order))
+	for i, node := range order {
+		index[node] = i
+	}
+
+	idom := map[intcode.AddressLocation]intcode.AddressLocation{entry: entry}
+
+	intersect := func(a intcode.AddressLocation, b intcode.AddressLocation) intcode.AddressLocation {
+		for a != b {
+			for index[a] > index[b] {
+				a = idom[a]
+			}
+
+			for index[b] > index[a] {
+				b = idom[b]
+			}
+		}
+
+		return a
+	}
+
+	for changed := true; changed; {
+		changed = false
+
+		for _, node := range order[1:] {
+			var newIdom intcode.AddressLocation
+
+			found := false
+
+			for _, predecessor := range f.predecessors[node] {
+				if _, processed := idom[predecessor]; !processed {
+					continue
+				}
+
+				if !found {
+					newIdom = predecessor
+					found = true
+
+					continue
+				}
+
+				newIdom = intersect(predecessor, newIdom)
+			}
+
+			if current, ok := idom[node]; !ok || current != newIdom {
+				idom[node] = newIdom
+				changed = true
+			}
+		}
+	}
+
+	return idom
+}
+
+// Check if a dominates b.
+func dominates(idom map[intcode.AddressLocation]intcode.AddressLocation, a intcode.AddressLocation, b intcode.AddressLocation) bool {
+	for {
+		if a == b {
+			return true
+		}
+
+		parent, ok := idom[b]
+		if !ok || parent == b {
+			return false
+		}
+
+		b = parent
+	}
+}
@@ -0,0 +1,45 @@
+package decompiler
+
+import (
+	"testing"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/intcode/analysis"
+	"github.com/giodamelio/aoc-2020-go/intcode/assembler"
+	"github.com/stretchr/testify/assert"
+)
+
+// An if else that joins back together before halting.
+func diamond() *flowGraph {
+	return newFlowGraph(analysis.Analyze(assembler.Assemble(`
+	INPUT		20
+	JUMP-IF-TRUE	20	i10
+	OUTPUT	i0
+	JUMP-IF-TRUE	i1	i12
+	OUTPUT	i1
+	HALT
+	`)))
+}
+
+func TestDominators(t *testing.T) {
+	idom := diamond().dominators(0)
+
+	assert.Equal(t, map[intcode.AddressLocation]intcode.AddressLocation{
+		0:  0,
+		5:  0,
+		10: 0,
+		12: 0,
+	}, idom)
+
+	assert.True(t, dominates(idom, 0, 12))
+	assert.False(t, dominates(idom, 5, 12))
+}
+
+func TestPostDominators(t *testing.T) {
+	ipdom := diamond().reverse().dominators(exitNode)
+
+	assert.Equal(t, intcode.AddressLocation(12), ipdom[0])
+	assert.Equal(t, intcode.AddressLocation(12), ipdom[5])
+	assert.Equal(t, intcode.AddressLocation(12), ipdom[10])
+	assert.Equal(t, exitNode, ipdom[12])
+}
@@ -0,0 +1,86 @@
+package decompiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/intcode/analysis"
+)
+
+// Render a parameter as a literal or a memory access.
+func operand(instruction analysis.Instruction, index int) string {
+	parameter := instruction.Parameters[index]
+
+	switch instruction.Modes[index] {
+	case intcode.Immediate:
+		return fmt.Sprintf("%d", parameter)
+	case intcode.Relative:
+		if parameter < 0 {
+			return fmt.Sprintf("m[rb-%d]", -parameter)
+		}
+
+		return fmt.Sprintf("m[rb+%d]", parameter)
+	}
+
+	return fmt.Sprintf("m[%d]", parameter)
+}
+
+// Render a binary operation, folding adding a negative literal into a subtraction.
+func binary(instruction analysis.Instruction, operator string) string {
+	lhs := operand(instruction, 0)
+	rhs := operand(instruction, 1)
+
+	if operator == "+" && instruction.Modes[1] == intcode.Immediate && instruction.Parameters[1] < 0 {
+		return fmt.Sprintf("%s - %d", lhs, -instruction.Parameters[1])
+	}
+
+	return fmt.Sprintf("%s %s %s", lhs, operator, rhs)
+}
+
+// Render a single non jump instruction as a statement.
+func statement(instruction analysis.Instruction) string {
+	switch instruction.Opcode.Opcode {
+	case intcode.ADD:
+		return fmt.Sprintf("%s = %s", operand(instruction, 2), binary(instruction, "+"))
+	case intcode.MULTIPLY:
+		return fmt.Sprintf("%s = %s", operand(instruction, 2), binary(instruction, "*"))
+	case intcode.INPUT:
+		return fmt.Sprintf("%s = input()", operand(instruction, 0))
+	case intcode.OUTPUT:
+		return fmt.Sprintf("output(%s)", operand(instruction, 0))
+	case intcode.LESSTHAN:
+		return fmt.Sprintf("%s = %s", operand(instruction, 2), binary(instruction, "<"))
+	case intcode.EQUALS:
+		return fmt.Sprintf("%s = %s", operand(instruction, 2), binary(instruction, "=="))
+	case intcode.ADJUSTRELATIVEBASE:
+		if instruction.Modes[0] == intcode.Immediate && instruction.Parameters[0] < 0 {
+			return fmt.Sprintf("rb -= %d", -instruction.Parameters[0])
+		}
+
+		return fmt.Sprintf("rb += %s", operand(instruction, 0))
+	case intcode.HALT:
+		return "halt()"
+	}
+
+	operands := make([]string, len(instruction.Parameters))
+	for i := range instruction.Parameters {
+		operands[i] = operand(instruction, i)
+	}
+
+	return fmt.Sprintf("%s(%s)", strings.ToLower(instruction.Opcode.Name), strings.Join(operands, ", "))
+}
+
+// Render the condition that makes a jump instruction jump, or not jump when negated.
+func condition(instruction analysis.Instruction, negate bool) string {
+	jumpsWhenNonZero := instruction.Opcode.Opcode == intcode.JUMPIFTRUE
+	if negate {
+		jumpsWhenNonZero = !jumpsWhenNonZero
+	}
+
+	if jumpsWhenNonZero {
+		return fmt.Sprintf("%s != 0", operand(instruction, 0))
+	}
+
+	return fmt.Sprintf("%s == 0", operand(instruction, 0))
+}
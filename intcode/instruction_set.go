@@ -0,0 +1,205 @@
+package intcode
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// InstructionSet is the collection of opcodes a computer understands.
+type InstructionSet struct {
+	opcodes map[AddressValue]Opcode
+	// The profile the set was built from, if any
+	profile string
+	// The parameter modes allowed, nil allows all of them
+	modes map[Mode]bool
+	// Nothing has been changed from the standard opcodes, so compiled code can stand in for it
+	standard bool
+}
+
+// NewInstructionSet creates an instruction set out of some opcodes.
+func NewInstructionSet(opcodes ...Opcode) (*InstructionSet, error) {
+	set := &InstructionSet{opcodes: make(map[AddressValue]Opcode, len(opcodes))}
+
+	for _, opcode := range opcodes {
+		if err := set.Register(opcode); err != nil {
+			return nil, err
+		}
+	}
+
+	return set, nil
+}
+
+// DefaultInstructionSet creates a fresh copy of the standard 2019 instruction set.
+func DefaultInstructionSet() *InstructionSet {
+	set := &InstructionSet{opcodes: make(map[AddressValue]Opcode, len(standardOpcodes))}
+
+	for _, opcode := range standardOpcodes {
+		set.opcodes[opcode.Opcode] = opcode
+	}
+
+	set.standard = true
+
+	return set
+}
+
+func validateOpcode(opcode Opcode) error {
+	// The opcode has to fit in the 1s and 10s columns
+	if opcode.Opcode < 1 || opcode.Opcode > 99 {
+		return fmt.Errorf("opcode must be between 1 and 99: %d", opcode.Opcode)
+	}
+
+	if opcode.Name == "" || strings.ContainsAny(opcode.Name, " \t\n") {
+		return fmt.Errorf("invalid opcode name: %q", opcode.Name)
+	}
+
+	if opcode.Execute == nil {
+		return fmt.Errorf("opcode %s has no handler", opcode.Name)
+	}
+
+	for _, parameter := range opcode.Parameters {
+		if parameter != Read && parameter != Write {
+			return fmt.Errorf("opcode %s has an invalid parameter: %d", opcode.Name, parameter)
+		}
+	}
+
+	return nil
+}
+
+// Register adds a new opcode, refusing to replace an existing number or name.
+func (s *InstructionSet) Register(opcode Opcode) error {
+	if err := validateOpcode(opcode); err != nil {
+		return err
+	}
+
+	if existing, ok := s.opcodes[opcode.Opcode]; ok {
+		return fmt.Errorf("opcode %d is already registered as %s", opcode.Opcode, existing.Name)
+	}
+
+	if existing, ok := s.Lookup(opcode.Name); ok {
+		return fmt.Errorf("opcode name %s is already registered as %d", opcode.Name, existing.Opcode)
+	}
+
+	s.opcodes[opcode.Opcode] = opcode
+	s.standard = false
+
+	return nil
+}
+
+// Override replaces the opcode with the same number, or adds it if there is none.
+func (s *InstructionSet) Override(opcode Opcode) error {
+	if err := validateOpcode(opcode); err != nil {
+		return err
+	}
+
+	if existing, ok := s.Lookup(opcode.Name); ok && existing.Opcode != opcode.Opcode {
+		return fmt.Errorf("opcode name %s is already registered as %d", opcode.Name, existing.Opcode)
+	}
+
+	s.opcodes[opcode.Opcode] = opcode
+	s.standard = false
+
+	return nil
+}
+
+// Remove an opcode so programs using it fail to run.
+func (s *InstructionSet) Remove(opcode AddressValue) {
+	delete(s.opcodes, opcode)
+	s.standard = false
+}
+
+// Get the definition of an opcode by number.
+func (s *InstructionSet) Get(opcode AddressValue) (Opcode, bool) {
+	definition, ok := s.opcodes[opcode]
+
+	return definition, ok
+}
+
+// Lookup the definition of an opcode by name.
+func (s *InstructionSet) Lookup(name string) (Opcode, bool) {
+	for _, opcode := range s.opcodes {
+		if opcode.Name == name {
+			return opcode, true
+		}
+	}
+
+	return Opcode{}, false
+}
+
+// Opcodes lists every opcode in the set, ordered by number.
+func (s *InstructionSet) Opcodes() []Opcode {
+	opcodes := make([]Opcode, 0, len(s.opcodes))
+	for _, opcode := range s.opcodes {
+		opcodes = append(opcodes, opcode)
+	}
+
+	sort.Slice(opcodes, func(i, j int) bool {
+		return opcodes[i].Opcode < opcodes[j].Opcode
+	})
+
+	return opcodes
+}
+
+// Copy the instruction set so it can be changed without affecting the original.
+func (s *InstructionSet) Copy() *InstructionSet {
+	set := &InstructionSet{
+		opcodes:  make(map[AddressValue]Opcode, len(s.opcodes)),
+		profile:  s.profile,
+		standard: s.standard,
+	}
+
+	for number, opcode := range s.opcodes {
+		set.opcodes[number] = opcode
+	}
+
+	if s.modes != nil {
+		set.modes = make(map[Mode]bool, len(s.modes))
+		for mode := range s.modes {
+			set.modes[mode] = true
+		}
+	}
+
+	return set
+}
+
+// AllowsMode checks if parameters can use a mode.
+func (s *InstructionSet) AllowsMode(mode Mode) bool {
+	return s.modes == nil || s.modes[mode]
+}
+
+// Explain why an opcode or mode is missing, naming the profile if the set came from one.
+func (s *InstructionSet) unavailableOpcodeError(opcode AddressValue) error {
+	if s.profile != "" {
+		for _, standard := range standardOpcodes {
+			if standard.Opcode == opcode {
+				return fmt.Errorf("opcode %d (%s) is not available in the %s profile", opcode, standard.Name, s.profile)
+			}
+		}
+	}
+
+	return fmt.Errorf("invalid opcode: %d", opcode)
+}
+
+func (s *InstructionSet) unavailableModeError(mode Mode) error {
+	if s.profile != "" {
+		return fmt.Errorf("parameter mode %d (%s) is not available in the %s profile", mode, mode, s.profile)
+	}
+
+	return fmt.Errorf("parameter mode %d (%s) is not available", mode, mode)
+}
+
+// Subset creates a restricted instruction set with only some of the opcodes.
+func (s *InstructionSet) Subset(opcodes ...AddressValue) (*InstructionSet, error) {
+	set := &InstructionSet{opcodes: make(map[AddressValue]Opcode, len(opcodes))}
+
+	for _, number := range opcodes {
+		opcode, ok := s.opcodes[number]
+		if !ok {
+			return nil, fmt.Errorf("invalid opcode: %d", number)
+		}
+
+		set.opcodes[number] = opcode
+	}
+
+	return set, nil
+}
@@ -0,0 +1,176 @@
+package intcode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Output the square of a number.
+func squareOpcode() Opcode {
+	return Opcode{
+		Name:       "SQUARE",
+		Opcode:     20,
+		Parameters: []ReadWrite{Read, Write},
+		Execute: func(computer *Computer, operation Opcode, parameters []AddressValue) error {
+			computer.Memory.Set(parameters[1], parameters[0]*parameters[0])
+			operation.IncrementInstructionPointer(computer)
+
+			return nil
+		},
+	}
+}
+
+func TestDefaultInstructionSet(t *testing.T) {
+	set := DefaultInstructionSet()
+
+	names := make([]string, 0)
+	for _, opcode := range set.Opcodes() {
+		names = append(names, opcode.Name)
+	}
+
+	assert.Equal(t, []string{
+		"ADD", "MULTIPLY", "INPUT", "OUTPUT", "JUMP-IF-TRUE", "JUMP-IF-FALSE", "LESS-THAN", "EQUALS", "ADJUST-RELATIVE-BASE",
+		"HALT",
+	}, names)
+
+	// Every call gets its own copy
+	set.Remove(ADD)
+
+	_, ok := DefaultInstructionSet().Get(ADD)
+	assert.True(t, ok)
+}
+
+func TestNewInstructionSet(t *testing.T) {
+	set, err := NewInstructionSet(squareOpcode())
+	assert.Nil(t, err)
+
+	opcode, ok := set.Get(20)
+	assert.True(t, ok)
+	assert.Equal(t, "SQUARE", opcode.Name)
+
+	_, ok = set.Get(ADD)
+	assert.False(t, ok)
+}
+
+func TestRegister(t *testing.T) {
+	set := DefaultInstructionSet()
+
+	assert.Nil(t, set.Register(squareOpcode()))
+
+	opcode, ok := set.Lookup("SQUARE")
+	assert.True(t, ok)
+	assert.Equal(t, AddressValue(20), opcode.Opcode)
+
+	err := set.Register(squareOpcode())
+	assert.Equal(t, "opcode 20 is already registered as SQUARE", err.Error())
+
+	renamed := squareOpcode()
+	renamed.Opcode = 21
+	err = set.Register(renamed)
+	assert.Equal(t, "opcode name SQUARE is already registered as 20", err.Error())
+}
+
+func TestRegisterInvalid(t *testing.T) {
+	set := DefaultInstructionSet()
+
+	opcode := squareOpcode()
+	opcode.Opcode = 100
+	assert.Equal(t, "opcode must be between 1 and 99: 100", set.Register(opcode).Error())
+
+	opcode = squareOpcode()
+	opcode.Name = "SQUARE IT"
+	assert.Equal(t, `invalid opcode name: "SQUARE IT"`, set.Register(opcode).Error())
+
+	opcode = squareOpcode()
+	opcode.Execute = nil
+	assert.Equal(t, "opcode SQUARE has no handler", set.Register(opcode).Error())
+
+	opcode = squareOpcode()
+	opcode.Parameters = []ReadWrite{Read, 2}
+	assert.Equal(t, "opcode SQUARE has an invalid parameter: 2", set.Register(opcode).Error())
+}
+
+func TestOverride(t *testing.T) {
+	set := DefaultInstructionSet()
+
+	// Make ADD subtract instead
+	subtract := squareOpcode()
+	subtract.Name = "ADD"
+	subtract.Opcode = ADD
+	assert.Nil(t, set.Override(subtract))
+
+	opcode, _ := set.Get(ADD)
+	assert.Equal(t, []ReadWrite{Read, Write}, opcode.Parameters)
+
+	// Names still have to be unique
+	subtract.Opcode = 30
+	assert.Equal(t, "opcode name ADD is already registered as 1", set.Override(subtract).Error())
+}
+
+func TestCopy(t *testing.T) {
+	set := DefaultInstructionSet()
+	copied := set.Copy()
+
+	copied.Remove(ADD)
+
+	_, ok := set.Get(ADD)
+	assert.True(t, ok)
+
+	_, ok = copied.Get(ADD)
+	assert.False(t, ok)
+}
+
+func TestSubset(t *testing.T) {
+	set, err := DefaultInstructionSet().Subset(ADD, MULTIPLY, HALT)
+	assert.Nil(t, err)
+	assert.Len(t, set.Opcodes(), 3)
+
+	_, err = DefaultInstructionSet().Subset(ADD, 50)
+	assert.Equal(t, "invalid opcode: 50", err.Error())
+}
+
+func TestComputerCustomOpcode(t *testing.T) {
+	set := DefaultInstructionSet()
+	assert.Nil(t, set.Register(squareOpcode()))
+
+	computer := NewComputer([]AddressValue{120, 7, 0, 99})
+	computer.SetInstructionSet(set)
+
+	computer.Run()
+
+	assert.Equal(t, []AddressValue{49, 7, 0, 99}, computer.Memory.rawMemory)
+}
+
+func TestComputerRestrictedInstructionSet(t *testing.T) {
+	set, err := DefaultInstructionSet().Subset(ADD, HALT)
+	assert.Nil(t, err)
+
+	computer := NewComputer([]AddressValue{1102, 2, 2, 0, 99})
+	computer.SetInstructionSet(set)
+
+	_, err = computer.Step()
+	assert.Equal(t, "invalid opcode: 2", err.Error())
+}
+
+func TestRequiresInterpreterInstructionSet(t *testing.T) {
+	computer := NewComputer([]AddressValue{99})
+	assert.False(t, computer.RequiresInterpreter())
+
+	// Copies of the default set can still be compiled
+	computer.SetInstructionSet(DefaultInstructionSet().Copy())
+	assert.False(t, computer.RequiresInterpreter())
+
+	set := DefaultInstructionSet()
+	assert.Nil(t, set.Register(squareOpcode()))
+	computer.SetInstructionSet(set)
+	assert.True(t, computer.RequiresInterpreter())
+
+	set = DefaultInstructionSet()
+	set.Remove(INPUT)
+	computer.SetInstructionSet(set)
+	assert.True(t, computer.RequiresInterpreter())
+
+	computer.SetInstructionSet(Day9Profile.InstructionSet())
+	assert.True(t, computer.RequiresInterpreter())
+}
@@ -1,29 +1,41 @@
 package intcode
 
-import "github.com/rs/zerolog/log"
+import (
+	"fmt"
+
+	"github.com/rs/zerolog"
+)
 
 type AddressLocation int64
 
 type AddressValue int64
 
+// Memory holds the program and anything it writes.
+//
+// Reads past the end are zero and writes past the end grow it, negative addresses are never valid.
 type Memory struct {
 	rawMemory []AddressValue
+	policy    *Policy
+	code      *codeTracker
+	log       zerolog.Logger
 }
 
 func newMemory(initialMemory []AddressValue) *Memory {
-	log.Trace().Msg("[MEMORY] Memory Created")
-
 	mem := new(Memory)
 	mem.rawMemory = initialMemory
+	mem.log = zerolog.Nop()
 
 	return mem
 }
 
 // Get the value of an address.
-func (im Memory) Get(address AddressLocation) AddressValue {
-	value := im.rawMemory[address]
+func (im *Memory) Get(address AddressLocation) AddressValue {
+	var value AddressValue
+	if int64(address) < int64(len(im.rawMemory)) {
+		value = im.rawMemory[address]
+	}
 
-	log.
+	im.log.
 		Trace().
 		Int64("address", int64(address)).
 		Int64("value", int64(value)).
@@ -33,10 +45,20 @@ func (im Memory) Get(address AddressLocation) AddressValue {
 }
 
 // GetRange gets the values from a range of addresses.
-func (im Memory) GetRange(address AddressLocation, length int64) []AddressValue {
-	value := im.rawMemory[address : int64(address)+length]
+func (im *Memory) GetRange(address AddressLocation, length int64) []AddressValue {
+	end := int64(address) + length
 
-	log.
+	var value []AddressValue
+	if end <= int64(len(im.rawMemory)) {
+		value = im.rawMemory[address:end]
+	} else {
+		value = make([]AddressValue, length)
+		if int64(address) < int64(len(im.rawMemory)) {
+			copy(value, im.rawMemory[address:])
+		}
+	}
+
+	im.log.
 		Trace().
 		Int64("address", int64(address)).
 		Int64("length", length).
@@ -47,14 +69,50 @@ func (im Memory) GetRange(address AddressLocation, length int64) []AddressValue
 	return value
 }
 
-// Set the value of an address.
-func (im Memory) Set(address AddressValue, value AddressValue) {
-	log.
+// Set the value of an address, as long as the policy allows it.
+func (im *Memory) Set(address AddressValue, value AddressValue) error {
+	if address < 0 {
+		return fmt.Errorf("negative address: %d", address)
+	}
+
+	if err := im.policy.checkWrite(AddressLocation(address)); err != nil {
+		return err
+	}
+
+	// Only grow once every check has passed, so a refused write leaves memory as it was
+	var old AddressValue
+	if int64(address) < int64(len(im.rawMemory)) {
+		old = im.rawMemory[address]
+	}
+
+	if err := im.code.write(AddressLocation(address), old, value); err != nil {
+		return err
+	}
+
+	im.log.
 		Trace().
 		Int64("address", int64(address)).
 		Int64("value", int64(value)).
-		Int64("oldvalue", int64(im.rawMemory[address])).
+		Int64("oldvalue", int64(old)).
 		Msg("[MEMORY] Set")
 
+	im.grow(int64(address) + 1)
 	im.rawMemory[address] = value
+
+	return nil
+}
+
+// Make sure there is room for at least size addresses, the new ones are zero.
+func (im *Memory) grow(size int64) {
+	if size <= int64(len(im.rawMemory)) {
+		return
+	}
+
+	im.log.
+		Trace().
+		Int64("from", int64(len(im.rawMemory))).
+		Int64("to", size).
+		Msg("[MEMORY] Grow")
+
+	im.rawMemory = append(im.rawMemory, make([]AddressValue, size-int64(len(im.rawMemory)))...)
 }
@@ -1,23 +1,11 @@
 package intcode
 
 import (
-	"os"
 	"testing"
 
-	"github.com/rs/zerolog"
-	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
 )
 
-func init() {
-	out := zerolog.NewConsoleWriter()
-	out.Out = os.Stderr
-	out.NoColor = true
-	log.Logger = log.Output(out)
-
-	zerolog.SetGlobalLevel(zerolog.TraceLevel)
-}
-
 func TestGet(t *testing.T) {
 	computer := NewComputer([]AddressValue{1, 2, 3})
 
@@ -30,9 +18,55 @@ func TestGetRange(t *testing.T) {
 	assert.Equal(t, []AddressValue{3, 4, 5}, computer.Memory.GetRange(2, 3))
 }
 
+func TestGetPastEnd(t *testing.T) {
+	computer := NewComputer([]AddressValue{1, 2, 3})
+
+	assert.Equal(t, AddressValue(0), computer.Memory.Get(1000))
+	assert.Equal(t, []AddressValue{2, 3, 0, 0}, computer.Memory.GetRange(1, 4))
+	assert.Equal(t, []AddressValue{0, 0}, computer.Memory.GetRange(10, 2))
+
+	// Reading does not grow the memory
+	assert.Len(t, computer.Memory.rawMemory, 3)
+}
+
 func TestSet(t *testing.T) {
 	computer := NewComputer([]AddressValue{1, 2, 3})
 
-	computer.Memory.Set(1, 10)
+	assert.Nil(t, computer.Memory.Set(1, 10))
 	assert.Equal(t, AddressValue(10), computer.Memory.Get(1))
 }
+
+func TestSetPastEnd(t *testing.T) {
+	computer := NewComputer([]AddressValue{1, 2, 3})
+
+	assert.Nil(t, computer.Memory.Set(5, 10))
+	assert.Equal(t, []AddressValue{1, 2, 3, 0, 0, 10}, computer.Memory.rawMemory)
+}
+
+func TestSetNegativeAddress(t *testing.T) {
+	computer := NewComputer([]AddressValue{1, 2, 3})
+
+	assert.Equal(t, "negative address: -1", computer.Memory.Set(-1, 10).Error())
+	assert.Equal(t, []AddressValue{1, 2, 3}, computer.Memory.rawMemory)
+}
+
+func TestSetWithPolicy(t *testing.T) {
+	computer := NewComputer([]AddressValue{1, 2, 3, 4})
+	computer.SetPolicy(&Policy{MaxMemory: 3, CodeSize: 1, ProtectCode: true})
+
+	assert.Nil(t, computer.Memory.Set(1, 10))
+	assert.Equal(t, "policy violation at 0: write to 0 is inside the code, which ends at 1", computer.Memory.Set(0, 10).Error())
+	assert.Equal(t, "policy violation at 0: address 3 is past the memory limit of 3", computer.Memory.Set(3, 10).Error())
+	assert.Equal(t, []AddressValue{1, 10, 3, 4}, computer.Memory.rawMemory)
+}
+
+func TestSetRefusedDoesNotGrow(t *testing.T) {
+	computer := NewComputer([]AddressValue{1, 2, 3})
+	computer.TrackSelfModification(FaultSelfModification)
+
+	// Pretend an instruction ran off the end of memory
+	computer.code.execute(3, 3)
+
+	assert.Equal(t, "self modifying code at 0: wrote 10 over 0 at 5, which has already run as code", computer.Memory.Set(5, 10).Error())
+	assert.Equal(t, []AddressValue{1, 2, 3}, computer.Memory.rawMemory)
+}
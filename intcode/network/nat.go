@@ -0,0 +1,66 @@
+package network
+
+import (
+	"errors"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+)
+
+// NATAddress is the address the NAT listens on.
+const NATAddress intcode.AddressValue = 255
+
+// NAT keeps the last packet sent to it and sends it to address 0 whenever the network is idle.
+//
+// The network is stopped the first time it sends the same Y value twice in a row.
+type NAT struct {
+	// The first packet the NAT received
+	First *Packet
+	// The most recent packet the NAT received
+	Last *Packet
+	// Every Y value the NAT has sent to address 0
+	Delivered []intcode.AddressValue
+}
+
+func (nat *NAT) Receive(network *Network, packet Packet) error {
+	if nat.First == nil {
+		first := packet
+		nat.First = &first
+	}
+
+	nat.Last = &packet
+
+	return nil
+}
+
+func (nat *NAT) Idle(network *Network) error {
+	if nat.Last == nil {
+		return errors.New("network is idle and the NAT has nothing to send")
+	}
+
+	packet := *nat.Last
+	packet.Source = NATAddress
+	packet.Destination = 0
+
+	network.log.Debug().Int64("y", int64(packet.Y)).Msg("[NAT] Waking up the network")
+
+	if count := len(nat.Delivered); count > 0 && nat.Delivered[count-1] == packet.Y {
+		nat.Delivered = append(nat.Delivered, packet.Y)
+		network.Stop()
+
+		return nil
+	}
+
+	nat.Delivered = append(nat.Delivered, packet.Y)
+
+	return network.Deliver(packet)
+}
+
+// Repeated is the first Y value the NAT sent twice in a row.
+func (nat *NAT) Repeated() (intcode.AddressValue, bool) {
+	count := len(nat.Delivered)
+	if count < 2 || nat.Delivered[count-1] != nat.Delivered[count-2] {
+		return 0, false
+	}
+
+	return nat.Delivered[count-1], true
+}
@@ -0,0 +1,258 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/intcode/scheduler"
+	"github.com/giodamelio/aoc-2020-go/logging"
+	"github.com/rs/zerolog"
+)
+
+// ErrIdle is returned when every node is waiting for packets and nothing is left to wake them up.
+var ErrIdle = errors.New("network is idle")
+
+// Packet is a single message sent between nodes.
+type Packet struct {
+	Source      intcode.AddressValue
+	Destination intcode.AddressValue
+	X           intcode.AddressValue
+	Y           intcode.AddressValue
+}
+
+// Device is something other than a computer that can be given an address on the network.
+type Device interface {
+	Receive(network *Network, packet Packet) error
+}
+
+// IdleHandler is told when the network goes idle so it can wake it back up.
+type IdleHandler interface {
+	Idle(network *Network) error
+}
+
+// Router decides what happens to every packet a node sends.
+type Router interface {
+	Route(network *Network, packet Packet) error
+}
+
+// DirectRouter delivers every packet straight to its destination.
+type DirectRouter struct{}
+
+func (DirectRouter) Route(network *Network, packet Packet) error {
+	return network.Deliver(packet)
+}
+
+// RouterFunc lets a plain function be used as a Router.
+type RouterFunc func(network *Network, packet Packet) error
+
+func (f RouterFunc) Route(network *Network, packet Packet) error {
+	return f(network, packet)
+}
+
+// DeviceFunc lets a plain function be used as a Device.
+type DeviceFunc func(network *Network, packet Packet) error
+
+func (f DeviceFunc) Receive(network *Network, packet Packet) error {
+	return f(network, packet)
+}
+
+type node struct {
+	address intcode.AddressValue
+	process *scheduler.Process
+	// Output values that do not make up a full packet yet
+	pending []intcode.AddressValue
+	// How many times in a row the computer asked for input and got nothing
+	idleReads int
+}
+
+// Network runs a group of computers that talk to each other with packets.
+//
+// Everything runs on a single goroutine, the nodes take turns in address order so the
+// same program always gives the same result.
+type Network struct {
+	scheduler    *scheduler.Scheduler
+	nodes        []*node
+	devices      map[intcode.AddressValue]Device
+	idleHandlers []IdleHandler
+	// Router every sent packet goes through
+	Router Router
+	// How many empty reads in a row before a node counts as idle
+	IdleThreshold int
+	stopped       bool
+	log           zerolog.Logger
+}
+
+// New creates a network of size computers all running program, addressed from 0.
+func New(program []intcode.AddressValue, size int) *Network {
+	network := &Network{
+		scheduler:     scheduler.New(),
+		devices:       make(map[intcode.AddressValue]Device),
+		Router:        DirectRouter{},
+		IdleThreshold: 2,
+		log:           zerolog.Nop(),
+	}
+
+	for address := 0; address < size; address++ {
+		computer := intcode.NewComputer(program)
+		computer.Name = fmt.Sprintf("nic-%d", address)
+
+		current := &node{
+			address: intcode.AddressValue(address),
+			process: network.scheduler.Add(computer),
+		}
+
+		current.process.OnOutput = func(value intcode.AddressValue) error {
+			return network.output(current, value)
+		}
+
+		// An empty queue reads as -1 and gives the next computer a turn
+		current.process.OnEmptyInput = func() (intcode.AddressValue, bool) {
+			current.idleReads++
+
+			return -1, true
+		}
+
+		// Every computer starts by reading its own address
+		current.process.Send(intcode.AddressValue(address))
+
+		network.nodes = append(network.nodes, current)
+	}
+
+	return network
+}
+
+// SetQuantum changes how many instructions a computer runs before the next one gets a turn.
+func (n *Network) SetQuantum(quantum int) {
+	n.scheduler.Quantum = quantum
+}
+
+// SetLogger sets where the network, its scheduler and every computer on it log to.
+func (n *Network) SetLogger(logger zerolog.Logger) {
+	n.log = logging.Subsystem(logger, logging.Network)
+	n.scheduler.Logger = logger
+
+	for _, current := range n.nodes {
+		current.process.Computer.SetLogger(logger)
+	}
+}
+
+// Computer gets the computer at an address.
+func (n *Network) Computer(address intcode.AddressValue) (*intcode.Computer, bool) {
+	if address < 0 || int(address) >= len(n.nodes) {
+		return nil, false
+	}
+
+	return n.nodes[address].process.Computer, true
+}
+
+// Attach a device to an address, it is also told when the network is idle if it can handle that.
+func (n *Network) Attach(address intcode.AddressValue, device Device) error {
+	if _, ok := n.Computer(address); ok {
+		return fmt.Errorf("address %d is already used by a computer", address)
+	}
+
+	if _, ok := n.devices[address]; ok {
+		return fmt.Errorf("address %d already has a device attached", address)
+	}
+
+	n.devices[address] = device
+
+	if handler, ok := device.(IdleHandler); ok {
+		n.idleHandlers = append(n.idleHandlers, handler)
+	}
+
+	return nil
+}
+
+// Deliver a packet to the computer or device at its destination.
+func (n *Network) Deliver(packet Packet) error {
+	n.log.
+		Debug().
+		Int64("source", int64(packet.Source)).
+		Int64("destination", int64(packet.Destination)).
+		Int64("x", int64(packet.X)).
+		Int64("y", int64(packet.Y)).
+		Msg("[NETWORK] Deliver")
+
+	if device, ok := n.devices[packet.Destination]; ok {
+		return device.Receive(n, packet)
+	}
+
+	if packet.Destination < 0 || int(packet.Destination) >= len(n.nodes) {
+		return fmt.Errorf("no computer or device at address %d", packet.Destination)
+	}
+
+	destination := n.nodes[packet.Destination]
+	destination.idleReads = 0
+	destination.process.Send(packet.X, packet.Y)
+
+	return nil
+}
+
+// Stop the network after the current turn.
+func (n *Network) Stop() {
+	n.stopped = true
+}
+
+// Collect output values into packets and route them.
+func (n *Network) output(current *node, value intcode.AddressValue) error {
+	current.idleReads = 0
+	current.pending = append(current.pending, value)
+
+	if len(current.pending) < 3 {
+		return nil
+	}
+
+	packet := Packet{
+		Source:      current.address,
+		Destination: current.pending[0],
+		X:           current.pending[1],
+		Y:           current.pending[2],
+	}
+	current.pending = nil
+
+	return n.Router.Route(n, packet)
+}
+
+// Check if every running node is out of packets and has been asking for more.
+func (n *Network) idle() bool {
+	for _, current := range n.nodes {
+		if current.process.Halted() {
+			continue
+		}
+
+		if current.process.Queued() > 0 || len(current.pending) > 0 || current.idleReads < n.IdleThreshold {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Run the network until it is stopped or every computer halts.
+func (n *Network) Run() error {
+	for !n.stopped && !n.scheduler.Done() {
+		if err := n.scheduler.Round(); err != nil {
+			return err
+		}
+
+		if n.stopped || n.scheduler.Done() || !n.idle() {
+			continue
+		}
+
+		n.log.Debug().Msg("[NETWORK] Idle")
+
+		if len(n.idleHandlers) == 0 {
+			return ErrIdle
+		}
+
+		for _, handler := range n.idleHandlers {
+			if err := handler.Idle(n); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package network
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+// Pad a program with zeros so it has room for data.
+func pad(program []intcode.AddressValue, size int) []intcode.AddressValue {
+	padded := make([]intcode.AddressValue, size)
+	copy(padded, program)
+
+	return padded
+}
+
+// Sends (address, address * 10) to the NAT on boot, then sends every packet it receives there too.
+var reporter = pad([]intcode.AddressValue{
+	3, 100, // m[100] = input()
+	1002, 100, 10, 101, // m[101] = m[100] * 10
+	104, 255, // output(255)
+	4, 100, // output(m[100])
+	4, 101, // output(m[101])
+	3, 102, // m[102] = input()
+	1008, 102, -1, 103, // m[103] = m[102] == -1
+	1005, 103, 12, // if m[103] != 0 goto 12
+	3, 101, // m[101] = input()
+	104, 255, // output(255)
+	4, 102, // output(m[102])
+	4, 101, // output(m[101])
+	1105, 1, 12, // goto 12
+}, 110)
+
+func TestNetworkWithNAT(t *testing.T) {
+	network := New(reporter, 4)
+	nat := &NAT{}
+
+	assert.Nil(t, network.Attach(NATAddress, nat))
+	assert.Nil(t, network.Run())
+
+	assert.Equal(t, &Packet{Source: 0, Destination: 255, X: 0, Y: 0}, nat.First)
+	assert.Equal(t, &Packet{Source: 0, Destination: 255, X: 3, Y: 30}, nat.Last)
+	assert.Equal(t, []intcode.AddressValue{30, 30}, nat.Delivered)
+
+	repeated, ok := nat.Repeated()
+	assert.True(t, ok)
+	assert.Equal(t, intcode.AddressValue(30), repeated)
+}
+
+func TestSetLogger(t *testing.T) {
+	var out bytes.Buffer
+
+	network := New(reporter, 2)
+	network.SetLogger(zerolog.New(&out).Level(zerolog.DebugLevel))
+
+	assert.Nil(t, network.Attach(NATAddress, &NAT{}))
+	assert.Nil(t, network.Run())
+
+	assert.Contains(t, out.String(), `{"level":"debug","subsystem":"network","source":0,"destination":255,"x":0,"y":0,`)
+	assert.Contains(t, out.String(), `{"level":"debug","subsystem":"network","y":10,"message":"[NAT] Waking up the network"}`)
+
+	computer, _ := network.Computer(1)
+	assert.Equal(t, zerolog.DebugLevel, computer.Logger().GetLevel())
+}
+
+func TestNetworkIdle(t *testing.T) {
+	network := New(reporter, 2)
+	network.Router = RouterFunc(func(network *Network, packet Packet) error {
+		return nil
+	})
+
+	assert.Equal(t, ErrIdle, network.Run())
+}
+
+func TestNetworkUnknownDestination(t *testing.T) {
+	network := New(reporter, 2)
+
+	assert.Equal(t, "nic-0: no computer or device at address 255", network.Run().Error())
+}
+
+func TestNetworkInvalidQuantum(t *testing.T) {
+	network := New(reporter, 2)
+	network.SetQuantum(0)
+
+	assert.Equal(t, "quantum must be at least 1: 0", network.Run().Error())
+}
+
+func TestNetworkHalts(t *testing.T) {
+	// Send a packet to the next computer, then halt
+	program := pad([]intcode.AddressValue{
+		3, 100, // m[100] = input()
+		101, 1, 100, 101, // m[101] = 1 + m[100]
+		4, 101, // output(m[101])
+		104, 7, // output(7)
+		104, 8, // output(8)
+		99,
+	}, 110)
+
+	network := New(program, 3)
+
+	var received []Packet
+
+	assert.Nil(t, network.Attach(3, DeviceFunc(func(network *Network, packet Packet) error {
+		received = append(received, packet)
+
+		return nil
+	})))
+	assert.Nil(t, network.Run())
+
+	assert.Equal(t, []Packet{{Source: 2, Destination: 3, X: 7, Y: 8}}, received)
+
+	computer, ok := network.Computer(1)
+	assert.True(t, ok)
+	assert.Equal(t, "halted", computer.State)
+}
+
+func TestAttach(t *testing.T) {
+	network := New(reporter, 2)
+
+	assert.Equal(t, "address 1 is already used by a computer", network.Attach(1, &NAT{}).Error())
+	assert.Nil(t, network.Attach(255, &NAT{}))
+	assert.Equal(t, "address 255 already has a device attached", network.Attach(255, &NAT{}).Error())
+}
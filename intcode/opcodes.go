@@ -1,8 +1,6 @@
 package intcode
 
-import (
-	"github.com/rs/zerolog/log"
-)
+import "fmt"
 
 type Mode int
 
@@ -10,8 +8,22 @@ type Mode int
 const (
 	Position Mode = iota
 	Immediate
+	Relative
 )
 
+func (m Mode) String() string {
+	switch m {
+	case Position:
+		return "position"
+	case Immediate:
+		return "immediate"
+	case Relative:
+		return "relative"
+	default:
+		return fmt.Sprintf("mode(%d)", int(m))
+	}
+}
+
 type ReadWrite int
 
 // Define the modes an instruction parameter can have.
@@ -22,120 +34,167 @@ const (
 
 // Define the ints behind all the opcodes.
 const (
-	ADD         = 1
-	MULTIPLY    = 2
-	INPUT       = 3
-	OUTPUT      = 4
-	JUMPIFTRUE  = 5
-	JUMPIFFALSE = 6
-	LESSTHAN    = 7
-	EQUALS      = 8
-	HALT        = 99
+	ADD                = 1
+	MULTIPLY           = 2
+	INPUT              = 3
+	OUTPUT             = 4
+	JUMPIFTRUE         = 5
+	JUMPIFFALSE        = 6
+	LESSTHAN           = 7
+	EQUALS             = 8
+	ADJUSTRELATIVEBASE = 9
+	HALT               = 99
 )
 
+// Handler runs an opcode with its already resolved parameters.
+//
+// It is responsible for moving the instruction pointer.
+type Handler func(computer *Computer, operation Opcode, parameters []AddressValue) error
+
 type Opcode struct {
 	Name       string
 	Opcode     AddressValue
 	Parameters []ReadWrite
-	execute    func(*Computer, Opcode, []AddressValue)
+	Execute    Handler
 }
 
-// The total length of the opcode including parameters.
-func (o Opcode) length() int {
+// Length is the total length of the opcode including parameters.
+func (o Opcode) Length() int {
 	return 1 + len(o.Parameters)
 }
 
-func (o Opcode) incrementInstructionPointer(computer *Computer) {
-	computer.SetInstructionPointer(computer.instructionPointer + AddressLocation(o.length()))
+// IncrementInstructionPointer moves the instruction pointer past the opcode.
+func (o Opcode) IncrementInstructionPointer(computer *Computer) {
+	computer.SetInstructionPointer(computer.instructionPointer + AddressLocation(o.Length()))
 }
 
-// Opcodes sets up all the operations our computer is capeable of.
-var Opcodes = map[AddressValue]Opcode{
-	ADD: {
+// The standard 2019 operations our computer is capeable of.
+var standardOpcodes = []Opcode{
+	{
 		Name:       "ADD",
 		Opcode:     ADD,
 		Parameters: []ReadWrite{Read, Read, Write},
-		execute: func(computer *Computer, operation Opcode, parameters []AddressValue) {
+		Execute: func(computer *Computer, operation Opcode, parameters []AddressValue) error {
 			leftHandSide := parameters[0]
 			rightHandSide := parameters[1]
 			result := leftHandSide + rightHandSide
-			computer.Memory.Set(parameters[2], result)
 
-			log.
+			if computer.overflowDetection && addOverflows(leftHandSide, rightHandSide) {
+				return &OverflowError{
+					InstructionPointer: computer.instructionPointer,
+					Opcode:             operation.Name,
+					LeftHandSide:       leftHandSide,
+					RightHandSide:      rightHandSide,
+				}
+			}
+
+			if err := computer.Memory.Set(parameters[2], result); err != nil {
+				return err
+			}
+
+			computer.opcodeLog.
 				Debug().
 				Int64("leftHandSide", int64(leftHandSide)).
 				Int64("rightHandSide", int64(rightHandSide)).
 				Int64("result", int64(result)).
 				Msg("[OPCODE] ADD")
 
-			operation.incrementInstructionPointer(computer)
+			operation.IncrementInstructionPointer(computer)
+
+			return nil
 		},
 	},
-	MULTIPLY: {
+	{
 		Name:       "MULTIPLY",
 		Opcode:     MULTIPLY,
 		Parameters: []ReadWrite{Read, Read, Write},
-		execute: func(computer *Computer, operation Opcode, parameters []AddressValue) {
+		Execute: func(computer *Computer, operation Opcode, parameters []AddressValue) error {
 			leftHandSide := parameters[0]
 			rightHandSide := parameters[1]
 			result := leftHandSide * rightHandSide
-			computer.Memory.Set(parameters[2], result)
 
-			log.
+			if computer.overflowDetection && multiplyOverflows(leftHandSide, rightHandSide) {
+				return &OverflowError{
+					InstructionPointer: computer.instructionPointer,
+					Opcode:             operation.Name,
+					LeftHandSide:       leftHandSide,
+					RightHandSide:      rightHandSide,
+				}
+			}
+
+			if err := computer.Memory.Set(parameters[2], result); err != nil {
+				return err
+			}
+
+			computer.opcodeLog.
 				Debug().
 				Int64("leftHandSide", int64(leftHandSide)).
 				Int64("rightHandSide", int64(rightHandSide)).
 				Int64("result", int64(result)).
 				Msg("[OPCODE] MULTIPLY")
 
-			operation.incrementInstructionPointer(computer)
+			operation.IncrementInstructionPointer(computer)
+
+			return nil
 		},
 	},
-	INPUT: {
+	{
 		Name:       "INPUT",
 		Opcode:     INPUT,
 		Parameters: []ReadWrite{Write},
-		execute: func(computer *Computer, operation Opcode, parameters []AddressValue) {
+		Execute: func(computer *Computer, operation Opcode, parameters []AddressValue) error {
 			address := parameters[0]
-			value := <-computer.Input
 
-			computer.Memory.Set(address, value)
+			value, err := computer.receive(operation)
+			if err != nil {
+				return err
+			}
+
+			if err := computer.Memory.Set(address, value); err != nil {
+				return err
+			}
 
-			log.
+			computer.opcodeLog.
 				Debug().
 				Int64("input", int64(value)).
 				Int64("address", int64(address)).
 				Msg("[OPCODE] INPUT")
 
-			operation.incrementInstructionPointer(computer)
+			operation.IncrementInstructionPointer(computer)
+
+			return nil
 		},
 	},
-	OUTPUT: {
+	{
 		Name:       "OUTPUT",
 		Opcode:     OUTPUT,
 		Parameters: []ReadWrite{Read},
-		execute: func(computer *Computer, operation Opcode, parameters []AddressValue) {
+		Execute: func(computer *Computer, operation Opcode, parameters []AddressValue) error {
 			value := parameters[0]
 
-			computer.Output <- value
+			if err := computer.send(operation, value); err != nil {
+				return err
+			}
 
-			log.
+			computer.opcodeLog.
 				Debug().
 				Int64("output", int64(value)).
 				Msg("[OPCODE] OUTPUT")
 
-			operation.incrementInstructionPointer(computer)
+			operation.IncrementInstructionPointer(computer)
+
+			return nil
 		},
 	},
-	JUMPIFTRUE: {
+	{
 		Name:       "JUMP-IF-TRUE",
 		Opcode:     JUMPIFTRUE,
 		Parameters: []ReadWrite{Read, Read},
-		execute: func(computer *Computer, operation Opcode, parameters []AddressValue) {
+		Execute: func(computer *Computer, operation Opcode, parameters []AddressValue) error {
 			condition := parameters[0]
 			address := parameters[1]
 
-			log.
+			computer.opcodeLog.
 				Debug().
 				Int64("condition", int64(condition)).
 				Int64("address", int64(address)).
@@ -144,19 +203,21 @@ var Opcodes = map[AddressValue]Opcode{
 			if condition != 0 {
 				computer.SetInstructionPointer(AddressLocation(address))
 			} else {
-				operation.incrementInstructionPointer(computer)
+				operation.IncrementInstructionPointer(computer)
 			}
+
+			return nil
 		},
 	},
-	JUMPIFFALSE: {
+	{
 		Name:       "JUMP-IF-FALSE",
 		Opcode:     JUMPIFFALSE,
 		Parameters: []ReadWrite{Read, Read},
-		execute: func(computer *Computer, operation Opcode, parameters []AddressValue) {
+		Execute: func(computer *Computer, operation Opcode, parameters []AddressValue) error {
 			condition := parameters[0]
 			address := parameters[1]
 
-			log.
+			computer.opcodeLog.
 				Debug().
 				Int64("condition", int64(condition)).
 				Int64("address", int64(address)).
@@ -165,15 +226,17 @@ var Opcodes = map[AddressValue]Opcode{
 			if condition == 0 {
 				computer.SetInstructionPointer(AddressLocation(address))
 			} else {
-				operation.incrementInstructionPointer(computer)
+				operation.IncrementInstructionPointer(computer)
 			}
+
+			return nil
 		},
 	},
-	LESSTHAN: {
+	{
 		Name:       "LESS-THAN",
 		Opcode:     LESSTHAN,
 		Parameters: []ReadWrite{Read, Read, Write},
-		execute: func(computer *Computer, operation Opcode, parameters []AddressValue) {
+		Execute: func(computer *Computer, operation Opcode, parameters []AddressValue) error {
 			lhs := parameters[0]
 			rhs := parameters[1]
 			outputAddress := parameters[2]
@@ -185,7 +248,7 @@ var Opcodes = map[AddressValue]Opcode{
 				output = 0
 			}
 
-			log.
+			computer.opcodeLog.
 				Debug().
 				Int64("lhs", int64(lhs)).
 				Int64("rhs", int64(rhs)).
@@ -193,16 +256,20 @@ var Opcodes = map[AddressValue]Opcode{
 				Int64("output", int64(output)).
 				Msg("[OPCODE] LESS-THAN")
 
-			computer.Memory.Set(outputAddress, output)
+			if err := computer.Memory.Set(outputAddress, output); err != nil {
+				return err
+			}
+
+			operation.IncrementInstructionPointer(computer)
 
-			operation.incrementInstructionPointer(computer)
+			return nil
 		},
 	},
-	EQUALS: {
+	{
 		Name:       "EQUALS",
 		Opcode:     EQUALS,
 		Parameters: []ReadWrite{Read, Read, Write},
-		execute: func(computer *Computer, operation Opcode, parameters []AddressValue) {
+		Execute: func(computer *Computer, operation Opcode, parameters []AddressValue) error {
 			lhs := parameters[0]
 			rhs := parameters[1]
 			outputAddress := parameters[2]
@@ -214,7 +281,7 @@ var Opcodes = map[AddressValue]Opcode{
 				output = 0
 			}
 
-			log.
+			computer.opcodeLog.
 				Debug().
 				Int64("lhs", int64(lhs)).
 				Int64("rhs", int64(rhs)).
@@ -222,19 +289,44 @@ var Opcodes = map[AddressValue]Opcode{
 				Int64("output", int64(output)).
 				Msg("[OPCODE] EQUALS")
 
-			computer.Memory.Set(outputAddress, output)
+			if err := computer.Memory.Set(outputAddress, output); err != nil {
+				return err
+			}
+
+			operation.IncrementInstructionPointer(computer)
 
-			operation.incrementInstructionPointer(computer)
+			return nil
 		},
 	},
-	HALT: {
+	{
+		Name:       "ADJUST-RELATIVE-BASE",
+		Opcode:     ADJUSTRELATIVEBASE,
+		Parameters: []ReadWrite{Read},
+		Execute: func(computer *Computer, operation Opcode, parameters []AddressValue) error {
+			offset := parameters[0]
+			computer.relativeBase += AddressLocation(offset)
+
+			computer.opcodeLog.
+				Debug().
+				Int64("offset", int64(offset)).
+				Int64("relativeBase", int64(computer.relativeBase)).
+				Msg("[OPCODE] ADJUST-RELATIVE-BASE")
+
+			operation.IncrementInstructionPointer(computer)
+
+			return nil
+		},
+	},
+	{
 		Name:       "HALT",
 		Opcode:     HALT,
 		Parameters: []ReadWrite{},
-		execute: func(computer *Computer, operation Opcode, parameters []AddressValue) {
-			log.
+		Execute: func(computer *Computer, operation Opcode, parameters []AddressValue) error {
+			computer.opcodeLog.
 				Debug().
 				Msg("[OPCODE] HALT")
+
+			return nil
 		},
 	},
 }
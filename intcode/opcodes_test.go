@@ -1,62 +1,95 @@
 package intcode
 
 import (
-	"os"
+	"math"
 	"testing"
 
-	"github.com/rs/zerolog"
-	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
 )
 
-func init() {
-	out := zerolog.NewConsoleWriter()
-	out.Out = os.Stderr
-	out.NoColor = true
-	log.Logger = log.Output(out)
+func standardOpcode(opcode AddressValue) Opcode {
+	definition, _ := DefaultInstructionSet().Get(opcode)
 
-	zerolog.SetGlobalLevel(zerolog.TraceLevel)
+	return definition
 }
 
 func TestLength(t *testing.T) {
-	opcodeAdd := Opcodes[1]
-	opcodeHalt := Opcodes[99]
+	opcodeAdd := standardOpcode(1)
+	opcodeHalt := standardOpcode(99)
 
-	assert.Equal(t, 4, opcodeAdd.length())
-	assert.Equal(t, 1, opcodeHalt.length())
+	assert.Equal(t, 4, opcodeAdd.Length())
+	assert.Equal(t, 1, opcodeHalt.Length())
 }
 
 func TestIncrementInstructionPointer(t *testing.T) {
-	opcodeAdd := Opcodes[1]
+	opcodeAdd := standardOpcode(1)
 	computer := NewComputer([]AddressValue{1, 1, 1, 0})
 
 	assert.Equal(t, AddressLocation(0), computer.instructionPointer)
 
-	opcodeAdd.incrementInstructionPointer(computer)
+	opcodeAdd.IncrementInstructionPointer(computer)
 
 	assert.Equal(t, AddressLocation(4), computer.instructionPointer)
 }
 
 func TestAdd(t *testing.T) {
-	opcodeAdd := Opcodes[1]
+	opcodeAdd := standardOpcode(1)
 	computer := NewComputer([]AddressValue{1, 1, 1, 0})
 
-	opcodeAdd.execute(computer, opcodeAdd, computer.Memory.rawMemory[1:])
+	err := opcodeAdd.Execute(computer, opcodeAdd, computer.Memory.rawMemory[1:])
+	assert.Nil(t, err)
 
 	assert.Equal(t, []AddressValue{2, 1, 1, 0}, computer.Memory.rawMemory)
 }
 
 func TestMultiply(t *testing.T) {
-	opcodeMultiply := Opcodes[2]
+	opcodeMultiply := standardOpcode(2)
 	computer := NewComputer([]AddressValue{2, 2, 2, 0})
 
-	opcodeMultiply.execute(computer, opcodeMultiply, computer.Memory.rawMemory[1:])
+	err := opcodeMultiply.Execute(computer, opcodeMultiply, computer.Memory.rawMemory[1:])
+	assert.Nil(t, err)
 
 	assert.Equal(t, []AddressValue{4, 2, 2, 0}, computer.Memory.rawMemory)
 }
 
+func TestAddOverflow(t *testing.T) {
+	opcodeAdd := standardOpcode(1)
+
+	// Wraps around by default
+	computer := NewComputer([]AddressValue{1101, math.MaxInt64, 1, 0})
+
+	err := opcodeAdd.Execute(computer, opcodeAdd, computer.Memory.rawMemory[1:])
+	assert.Nil(t, err)
+	assert.Equal(t, AddressValue(math.MinInt64), computer.Memory.rawMemory[0])
+
+	computer = NewComputer([]AddressValue{1101, math.MaxInt64, 1, 0})
+	computer.SetOverflowDetection(true)
+
+	err = opcodeAdd.Execute(computer, opcodeAdd, computer.Memory.rawMemory[1:])
+	assert.Equal(t, &OverflowError{
+		InstructionPointer: 0,
+		Opcode:             "ADD",
+		LeftHandSide:       math.MaxInt64,
+		RightHandSide:      1,
+	}, err)
+	assert.Equal(t, []AddressValue{1101, math.MaxInt64, 1, 0}, computer.Memory.rawMemory)
+}
+
+func TestMultiplyOverflow(t *testing.T) {
+	opcodeMultiply := standardOpcode(2)
+	computer := NewComputer([]AddressValue{1102, 1 << 32, 1 << 32, 0})
+	computer.SetOverflowDetection(true)
+
+	err := opcodeMultiply.Execute(computer, opcodeMultiply, computer.Memory.rawMemory[1:])
+	assert.Equal(
+		t,
+		"integer overflow in MULTIPLY at 0: 4294967296 and 4294967296 do not fit in 64 bits",
+		err.Error(),
+	)
+}
+
 func TestInput(t *testing.T) {
-	opcodeInput := Opcodes[3]
+	opcodeInput := standardOpcode(3)
 	computer := NewComputer([]AddressValue{3, 2, 0})
 
 	input := make(chan AddressValue)
@@ -66,31 +99,34 @@ func TestInput(t *testing.T) {
 		input <- 10
 	}()
 
-	opcodeInput.execute(computer, opcodeInput, computer.Memory.rawMemory[1:])
+	err := opcodeInput.Execute(computer, opcodeInput, computer.Memory.rawMemory[1:])
+	assert.Nil(t, err)
 
 	assert.Equal(t, []AddressValue{3, 2, 10}, computer.Memory.rawMemory)
 }
 
 func TestOutput(t *testing.T) {
-	opcodeOutput := Opcodes[4]
+	opcodeOutput := standardOpcode(4)
 	computer := NewComputer([]AddressValue{4, 10, 10})
 
 	output := make(chan AddressValue, 1)
 	computer.Output = output
 
-	opcodeOutput.execute(computer, opcodeOutput, computer.Memory.rawMemory[1:])
+	err := opcodeOutput.Execute(computer, opcodeOutput, computer.Memory.rawMemory[1:])
+	assert.Nil(t, err)
 
 	assert.Equal(t, []AddressValue{4, 10, 10}, computer.Memory.rawMemory)
 	assert.Equal(t, AddressValue(10), <-output)
 }
 
 func TestJumpIfTrue(t *testing.T) {
-	opcodeJumpIfTrue := Opcodes[5]
+	opcodeJumpIfTrue := standardOpcode(5)
 
 	// Jump case
 	computer := NewComputer([]AddressValue{1105, 1, 22, 99})
 
-	opcodeJumpIfTrue.execute(computer, opcodeJumpIfTrue, computer.Memory.rawMemory[1:])
+	err := opcodeJumpIfTrue.Execute(computer, opcodeJumpIfTrue, computer.Memory.rawMemory[1:])
+	assert.Nil(t, err)
 
 	assert.Equal(t, []AddressValue{1105, 1, 22, 99}, computer.Memory.rawMemory)
 	assert.Equal(t, AddressLocation(22), computer.instructionPointer)
@@ -98,19 +134,21 @@ func TestJumpIfTrue(t *testing.T) {
 	// Continue case
 	computer = NewComputer([]AddressValue{1105, 0, 22, 99})
 
-	opcodeJumpIfTrue.execute(computer, opcodeJumpIfTrue, computer.Memory.rawMemory[1:])
+	err = opcodeJumpIfTrue.Execute(computer, opcodeJumpIfTrue, computer.Memory.rawMemory[1:])
+	assert.Nil(t, err)
 
 	assert.Equal(t, []AddressValue{1105, 0, 22, 99}, computer.Memory.rawMemory)
 	assert.Equal(t, AddressLocation(3), computer.instructionPointer)
 }
 
 func TestJumpIfFalse(t *testing.T) {
-	opcodeJumpIfFalse := Opcodes[6]
+	opcodeJumpIfFalse := standardOpcode(6)
 
 	// Jump case
 	computer := NewComputer([]AddressValue{1106, 0, 22, 99})
 
-	opcodeJumpIfFalse.execute(computer, opcodeJumpIfFalse, computer.Memory.rawMemory[1:])
+	err := opcodeJumpIfFalse.Execute(computer, opcodeJumpIfFalse, computer.Memory.rawMemory[1:])
+	assert.Nil(t, err)
 
 	assert.Equal(t, []AddressValue{1106, 0, 22, 99}, computer.Memory.rawMemory)
 	assert.Equal(t, AddressLocation(22), computer.instructionPointer)
@@ -118,19 +156,21 @@ func TestJumpIfFalse(t *testing.T) {
 	// Continue case
 	computer = NewComputer([]AddressValue{1106, 1, 22, 99})
 
-	opcodeJumpIfFalse.execute(computer, opcodeJumpIfFalse, computer.Memory.rawMemory[1:])
+	err = opcodeJumpIfFalse.Execute(computer, opcodeJumpIfFalse, computer.Memory.rawMemory[1:])
+	assert.Nil(t, err)
 
 	assert.Equal(t, []AddressValue{1106, 1, 22, 99}, computer.Memory.rawMemory)
 	assert.Equal(t, AddressLocation(3), computer.instructionPointer)
 }
 
 func TestLessThan(t *testing.T) {
-	opcodeLessThan := Opcodes[7]
+	opcodeLessThan := standardOpcode(7)
 
 	// Test less then case
 	computer := NewComputer([]AddressValue{1107, 10, 20, 0, 99})
 
-	opcodeLessThan.execute(computer, opcodeLessThan, computer.Memory.rawMemory[1:])
+	err := opcodeLessThan.Execute(computer, opcodeLessThan, computer.Memory.rawMemory[1:])
+	assert.Nil(t, err)
 
 	assert.Equal(t, []AddressValue{1, 10, 20, 0, 99}, computer.Memory.rawMemory)
 	assert.Equal(t, AddressLocation(4), computer.instructionPointer)
@@ -138,19 +178,21 @@ func TestLessThan(t *testing.T) {
 	// Test not less then case
 	computer = NewComputer([]AddressValue{1107, 20, 10, 0, 99})
 
-	opcodeLessThan.execute(computer, opcodeLessThan, computer.Memory.rawMemory[1:])
+	err = opcodeLessThan.Execute(computer, opcodeLessThan, computer.Memory.rawMemory[1:])
+	assert.Nil(t, err)
 
 	assert.Equal(t, []AddressValue{0, 20, 10, 0, 99}, computer.Memory.rawMemory)
 	assert.Equal(t, AddressLocation(4), computer.instructionPointer)
 }
 
 func TestEquals(t *testing.T) {
-	opcodeEquals := Opcodes[8]
+	opcodeEquals := standardOpcode(8)
 
 	// Test equals
 	computer := NewComputer([]AddressValue{1108, 10, 10, 0, 99})
 
-	opcodeEquals.execute(computer, opcodeEquals, computer.Memory.rawMemory[1:])
+	err := opcodeEquals.Execute(computer, opcodeEquals, computer.Memory.rawMemory[1:])
+	assert.Nil(t, err)
 
 	assert.Equal(t, []AddressValue{1, 10, 10, 0, 99}, computer.Memory.rawMemory)
 	assert.Equal(t, AddressLocation(4), computer.instructionPointer)
@@ -158,17 +200,35 @@ func TestEquals(t *testing.T) {
 	// Test not equals
 	computer = NewComputer([]AddressValue{1108, 10, 20, 0, 99})
 
-	opcodeEquals.execute(computer, opcodeEquals, computer.Memory.rawMemory[1:])
+	err = opcodeEquals.Execute(computer, opcodeEquals, computer.Memory.rawMemory[1:])
+	assert.Nil(t, err)
 
 	assert.Equal(t, []AddressValue{0, 10, 20, 0, 99}, computer.Memory.rawMemory)
 	assert.Equal(t, AddressLocation(4), computer.instructionPointer)
 }
 
+func TestAdjustRelativeBase(t *testing.T) {
+	opcodeAdjustRelativeBase := standardOpcode(9)
+	computer := NewComputer([]AddressValue{109, 19, 99})
+	computer.relativeBase = 2000
+
+	err := opcodeAdjustRelativeBase.Execute(computer, opcodeAdjustRelativeBase, []AddressValue{19})
+	assert.Nil(t, err)
+	assert.Equal(t, AddressLocation(2019), computer.RelativeBase())
+	assert.Equal(t, AddressLocation(2), computer.instructionPointer)
+
+	// The base can move backwards
+	err = opcodeAdjustRelativeBase.Execute(computer, opcodeAdjustRelativeBase, []AddressValue{-20})
+	assert.Nil(t, err)
+	assert.Equal(t, AddressLocation(1999), computer.RelativeBase())
+}
+
 func TestHalt(t *testing.T) {
-	opcodeHalt := Opcodes[99]
+	opcodeHalt := standardOpcode(99)
 	computer := NewComputer([]AddressValue{99})
 
-	opcodeHalt.execute(computer, opcodeHalt, []AddressValue{})
+	err := opcodeHalt.Execute(computer, opcodeHalt, []AddressValue{})
+	assert.Nil(t, err)
 
 	assert.Equal(t, []AddressValue{99}, computer.Memory.rawMemory)
 }
@@ -0,0 +1,137 @@
+package pipeline
+
+import (
+	"sync"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+)
+
+// The lock every inbox of a pipeline shares, so it can tell when no computer can make progress.
+type switchboard struct {
+	mutex   sync.Mutex
+	ready   *sync.Cond
+	inboxes []*inbox
+	running int
+	stuck   bool
+}
+
+func newSwitchboard() *switchboard {
+	board := &switchboard{}
+	board.ready = sync.NewCond(&board.mutex)
+
+	return board
+}
+
+func (board *switchboard) newInbox() *inbox {
+	board.mutex.Lock()
+	defer board.mutex.Unlock()
+
+	box := &inbox{board: board}
+	board.inboxes = append(board.inboxes, box)
+
+	return box
+}
+
+func (board *switchboard) start(running int) {
+	board.mutex.Lock()
+	defer board.mutex.Unlock()
+
+	board.running = running
+	board.stuck = false
+}
+
+// A computer stopped running, so nothing it writes to will get any more values from it.
+func (board *switchboard) finish(outputs []*inbox) {
+	board.mutex.Lock()
+	defer board.mutex.Unlock()
+
+	for _, output := range outputs {
+		output.producers--
+	}
+
+	board.running--
+	board.ready.Broadcast()
+	board.checkStuck()
+}
+
+// Check if every running computer is waiting for a value that can never come.
+//
+// It has to be called with the lock held.
+func (board *switchboard) checkStuck() {
+	if board.running == 0 {
+		return
+	}
+
+	waiting := 0
+
+	for _, box := range board.inboxes {
+		if box.waiting && len(box.values) == 0 && box.producers > 0 {
+			waiting++
+		}
+	}
+
+	if waiting == board.running {
+		board.stuck = true
+		board.ready.Broadcast()
+	}
+}
+
+func (board *switchboard) isStuck() bool {
+	board.mutex.Lock()
+	defer board.mutex.Unlock()
+
+	return board.stuck
+}
+
+// An unbounded queue of values waiting to be read by a computer.
+//
+// It is closed once every computer writing to it has stopped and it has been emptied.
+type inbox struct {
+	board     *switchboard
+	values    []intcode.AddressValue
+	producers int
+	waiting   bool
+}
+
+func (box *inbox) push(values ...intcode.AddressValue) {
+	box.board.mutex.Lock()
+	defer box.board.mutex.Unlock()
+
+	box.values = append(box.values, values...)
+	box.board.ready.Broadcast()
+}
+
+// Wait for the next value, returning false if none will ever come.
+func (box *inbox) pop() (intcode.AddressValue, bool) {
+	board := box.board
+
+	board.mutex.Lock()
+	defer board.mutex.Unlock()
+
+	for len(box.values) == 0 && box.producers > 0 && !board.stuck {
+		box.waiting = true
+		board.checkStuck()
+
+		if !board.stuck {
+			board.ready.Wait()
+		}
+	}
+
+	box.waiting = false
+
+	if len(box.values) == 0 {
+		return 0, false
+	}
+
+	value := box.values[0]
+	box.values = box.values[1:]
+
+	return value, true
+}
+
+func (box *inbox) addProducer() {
+	box.board.mutex.Lock()
+	defer box.board.mutex.Unlock()
+
+	box.producers++
+}
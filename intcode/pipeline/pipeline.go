@@ -0,0 +1,274 @@
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/logging"
+)
+
+// Tap records every value a computer outputs.
+type Tap struct {
+	mutex  sync.Mutex
+	values []intcode.AddressValue
+}
+
+func (t *Tap) record(value intcode.AddressValue) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.values = append(t.values, value)
+}
+
+// Values gets a copy of everything recorded so far.
+func (t *Tap) Values() []intcode.AddressValue {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	values := make([]intcode.AddressValue, len(t.values))
+	copy(values, t.values)
+
+	return values
+}
+
+// Last gets the most recently recorded value.
+func (t *Tap) Last() (intcode.AddressValue, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if len(t.values) == 0 {
+		return 0, false
+	}
+
+	return t.values[len(t.values)-1], true
+}
+
+type node struct {
+	computer *intcode.Computer
+	inbox    *inbox
+	outputs  []*inbox
+	taps     []*Tap
+	// Stopped because every computer was waiting for input
+	deadlocked bool
+}
+
+var errDeadlocked = errors.New("deadlocked")
+
+// Pipeline wires the inputs and outputs of computers together.
+//
+// Every computer reads from its own unbounded queue, so sending a value never blocks and a
+// computer that halts never leaves another one stuck writing to it. A computer waiting for
+// input stops once every computer feeding it has stopped and its queue is empty, and every
+// computer is stopped if they all end up waiting on each other.
+type Pipeline struct {
+	nodes []*node
+	board *switchboard
+}
+
+// New creates an empty pipeline.
+func New() *Pipeline {
+	return &Pipeline{board: newSwitchboard()}
+}
+
+// Add a computer to the pipeline with some initial inputs, like a phase setting.
+//
+// It returns the index used to connect the computer to others.
+func (p *Pipeline) Add(computer *intcode.Computer, initial ...intcode.AddressValue) int {
+	// The pipeline feeds and drains the channels itself between steps
+	computer.Input = make(chan intcode.AddressValue, 1)
+	computer.Output = make(chan intcode.AddressValue, 1)
+
+	box := p.board.newInbox()
+	box.push(initial...)
+
+	p.nodes = append(p.nodes, &node{computer: computer, inbox: box})
+
+	return len(p.nodes) - 1
+}
+
+// Connect the output of one computer to the input of another.
+func (p *Pipeline) Connect(from int, to int) {
+	p.nodes[from].outputs = append(p.nodes[from].outputs, p.nodes[to].inbox)
+	p.nodes[to].inbox.addProducer()
+}
+
+// FanOut sends every output of one computer to each of the others.
+func (p *Pipeline) FanOut(from int, to ...int) {
+	for _, destination := range to {
+		p.Connect(from, destination)
+	}
+}
+
+// FanIn merges the outputs of several computers into the input of one.
+func (p *Pipeline) FanIn(to int, from ...int) {
+	for _, source := range from {
+		p.Connect(source, to)
+	}
+}
+
+// Send values to the input of a computer.
+func (p *Pipeline) Send(to int, values ...intcode.AddressValue) {
+	p.nodes[to].inbox.push(values...)
+}
+
+// Tap records the outputs of a computer.
+func (p *Pipeline) Tap(node int) *Tap {
+	tap := &Tap{}
+	p.nodes[node].taps = append(p.nodes[node].taps, tap)
+
+	return tap
+}
+
+// Chain connects computers one after another, each with its own phase setting.
+func Chain(computers []*intcode.Computer, phases []intcode.AddressValue) *Pipeline {
+	p := New()
+
+	for i, computer := range computers {
+		if i < len(phases) {
+			p.Add(computer, phases[i])
+		} else {
+			p.Add(computer)
+		}
+
+		if i > 0 {
+			p.Connect(i-1, i)
+		}
+	}
+
+	return p
+}
+
+// Ring is a chain with the last computer feeding back into the first.
+func Ring(computers []*intcode.Computer, phases []intcode.AddressValue) *Pipeline {
+	p := Chain(computers, phases)
+
+	if len(computers) > 0 {
+		p.Connect(len(computers)-1, 0)
+	}
+
+	return p
+}
+
+// Run a single computer, moving values between its queue and its neighbours.
+func (n *node) run() error {
+	computer := n.computer
+
+	defer n.inbox.board.finish(n.outputs)
+
+	for {
+		opcode, err := computer.NextOpcode()
+		if err != nil {
+			return fmt.Errorf("%s: %w", computer.Name, err)
+		}
+
+		if opcode == intcode.INPUT {
+			value, ok := n.inbox.pop()
+			if !ok {
+				logger := logging.Subsystem(computer.Logger(), logging.Pipeline)
+				computer.State = "stopped"
+
+				if n.inbox.board.isStuck() {
+					logger.Debug().Str("name", computer.Name).Msg("[PIPELINE] Deadlocked")
+
+					return errDeadlocked
+				}
+
+				logger.Debug().Str("name", computer.Name).Msg("[PIPELINE] No more input")
+
+				return nil
+			}
+
+			computer.Input <- value
+		}
+
+		_, err = computer.Step()
+		if err != nil {
+			return fmt.Errorf("%s: %w", computer.Name, err)
+		}
+
+		switch opcode {
+		case intcode.OUTPUT:
+			value := <-computer.Output
+
+			for _, tap := range n.taps {
+				tap.record(value)
+			}
+
+			for _, output := range n.outputs {
+				output.push(value)
+			}
+		case intcode.HALT:
+			computer.Halt()
+
+			return nil
+		}
+	}
+}
+
+// Run every computer until they have all halted or run out of input.
+//
+// The first error any computer hits is returned. If the computers still running all end up
+// waiting for input from each other they are stopped and a deadlock is reported instead of
+// waiting forever.
+func (p *Pipeline) Run() error {
+	var (
+		wait       sync.WaitGroup
+		mutex      sync.Mutex
+		firstError error
+	)
+
+	p.board.start(len(p.nodes))
+
+	for _, n := range p.nodes {
+		n.computer.State = "running"
+		n.deadlocked = false
+
+		wait.Add(1)
+
+		go func(n *node) {
+			defer wait.Done()
+
+			err := n.run()
+			if errors.Is(err, errDeadlocked) {
+				n.deadlocked = true
+
+				return
+			}
+
+			if err != nil {
+				mutex.Lock()
+				if firstError == nil {
+					firstError = err
+				}
+				mutex.Unlock()
+			}
+		}(n)
+	}
+
+	wait.Wait()
+
+	if firstError != nil {
+		return firstError
+	}
+
+	var blocked []string
+
+	for _, n := range p.nodes {
+		if n.deadlocked {
+			blocked = append(blocked, fmt.Sprintf(
+				"%s is waiting for input at %d",
+				n.computer.Name,
+				n.computer.InstructionPointer(),
+			))
+		}
+	}
+
+	if len(blocked) > 0 {
+		return fmt.Errorf("deadlock: %s", strings.Join(blocked, ", "))
+	}
+
+	return nil
+}
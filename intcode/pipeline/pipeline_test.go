@@ -0,0 +1,139 @@
+package pipeline
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/stretchr/testify/assert"
+)
+
+// Take an input, double it and output the result.
+var double = []intcode.AddressValue{3, 9, 102, 2, 9, 9, 4, 9, 99, 0}
+
+// Keep reading inputs and output each one doubled.
+var doubleForever = []intcode.AddressValue{3, 9, 102, 2, 9, 9, 4, 9, 1105, 1, 0}
+
+func computers(program []intcode.AddressValue, count int) []*intcode.Computer {
+	list := make([]*intcode.Computer, count)
+	for i := range list {
+		list[i] = intcode.NewComputer(program)
+	}
+
+	return list
+}
+
+func TestChain(t *testing.T) {
+	p := Chain(computers(double, 3), nil)
+	tap := p.Tap(2)
+
+	p.Send(0, 5)
+
+	assert.Nil(t, p.Run())
+	assert.Equal(t, []intcode.AddressValue{40}, tap.Values())
+}
+
+func TestRing(t *testing.T) {
+	program := []intcode.AddressValue{
+		3, 26, 1001, 26, -4, 26, 3, 27, 1002, 27, 2, 27, 1, 27, 26,
+		27, 4, 27, 1001, 28, -1, 28, 1005, 28, 6, 99, 0, 0, 5,
+	}
+
+	p := Ring(computers(program, 5), []intcode.AddressValue{9, 8, 7, 6, 5})
+	tap := p.Tap(4)
+
+	p.Send(0, 0)
+
+	assert.Nil(t, p.Run())
+
+	last, ok := tap.Last()
+	assert.True(t, ok)
+	assert.Equal(t, intcode.AddressValue(139629729), last)
+}
+
+func TestFanOutAndFanIn(t *testing.T) {
+	p := New()
+	source := p.Add(intcode.NewComputer(doubleForever), 1, 2)
+	left := p.Add(intcode.NewComputer(doubleForever))
+	right := p.Add(intcode.NewComputer(doubleForever))
+	sink := p.Add(intcode.NewComputer(doubleForever))
+
+	p.FanOut(source, left, right)
+	p.FanIn(sink, left, right)
+
+	tap := p.Tap(sink)
+
+	assert.Nil(t, p.Run())
+
+	values := tap.Values()
+	sort.Slice(values, func(i, j int) bool {
+		return values[i] < values[j]
+	})
+
+	assert.Equal(t, []intcode.AddressValue{8, 8, 16, 16}, values)
+}
+
+func TestStopsWhenOutOfInput(t *testing.T) {
+	list := computers(doubleForever, 2)
+	p := Chain(list, nil)
+	tap := p.Tap(1)
+
+	p.Send(0, 1, 2, 3)
+
+	assert.Nil(t, p.Run())
+	assert.Equal(t, []intcode.AddressValue{4, 8, 12}, tap.Values())
+	assert.Equal(t, "stopped", list[0].State)
+	assert.Equal(t, "stopped", list[1].State)
+}
+
+func TestRunError(t *testing.T) {
+	list := computers(double, 2)
+	list[1].Name = "broken"
+	list[1].Memory.Set(0, 50)
+
+	p := Chain(list, nil)
+	p.Send(0, 1)
+
+	assert.Equal(t, "broken: invalid opcode: 50", p.Run().Error())
+}
+
+func TestRingDeadlock(t *testing.T) {
+	list := computers(doubleForever, 3)
+	for i, computer := range list {
+		computer.Name = fmt.Sprintf("amp-%d", i)
+	}
+
+	// Nobody ever gets a first value to pass around
+	p := Ring(list, nil)
+
+	assert.Equal(
+		t,
+		"deadlock: amp-0 is waiting for input at 0, amp-1 is waiting for input at 0, amp-2 is waiting for input at 0",
+		p.Run().Error(),
+	)
+
+	for _, computer := range list {
+		assert.Equal(t, "stopped", computer.State)
+	}
+}
+
+func TestDeadlockAfterHalt(t *testing.T) {
+	p := New()
+	first := p.Add(intcode.NewComputer(double), 4)
+	left := p.Add(intcode.NewComputer(doubleForever))
+	right := p.Add(intcode.NewComputer(doubleForever))
+
+	// The pair only waits on each other once the other computer has halted
+	p.Connect(left, right)
+	p.Connect(right, left)
+
+	tap := p.Tap(first)
+
+	assert.Equal(
+		t,
+		"deadlock: computer is waiting for input at 0, computer is waiting for input at 0",
+		p.Run().Error(),
+	)
+	assert.Equal(t, []intcode.AddressValue{8}, tap.Values())
+}
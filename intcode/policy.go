@@ -0,0 +1,125 @@
+package intcode
+
+import "fmt"
+
+// Rule is the part of a policy that was broken.
+type Rule string
+
+const (
+	MaxMemoryRule       Rule = "max-memory"
+	MaxInstructionsRule Rule = "max-instructions"
+	MaxOutputsRule      Rule = "max-outputs"
+	CodeWriteRule       Rule = "code-write"
+	DataExecutionRule   Rule = "data-execution"
+)
+
+// Policy limits what a program is allowed to do, for running programs we do not trust.
+//
+// Zero values turn a limit off.
+type Policy struct {
+	// Addresses at or above this can not be written to or run
+	MaxMemory AddressLocation
+	// How many instructions can run in total
+	MaxInstructions int64
+	// How many values can be output in total
+	MaxOutputs int64
+	// Addresses below this are the program's code, everything after is data
+	CodeSize AddressLocation
+	// Fail on writes to the code, needs a CodeSize
+	ProtectCode bool
+	// Fail when the instruction pointer leaves the code, like jumping into data, needs a CodeSize
+	ForbidDataExecution bool
+}
+
+// PolicyViolation is returned when a program does something its policy does not allow.
+type PolicyViolation struct {
+	Rule               Rule
+	InstructionPointer AddressLocation
+	// The address being written or run, if the rule is about one
+	Address AddressLocation
+	// The limit that was reached
+	Limit int64
+}
+
+func (v *PolicyViolation) Error() string {
+	var reason string
+
+	switch v.Rule {
+	case MaxMemoryRule:
+		reason = fmt.Sprintf("address %d is past the memory limit of %d", v.Address, v.Limit)
+	case MaxInstructionsRule:
+		reason = fmt.Sprintf("instruction limit of %d reached", v.Limit)
+	case MaxOutputsRule:
+		reason = fmt.Sprintf("output limit of %d reached", v.Limit)
+	case CodeWriteRule:
+		reason = fmt.Sprintf("write to %d is inside the code, which ends at %d", v.Address, v.Limit)
+	case DataExecutionRule:
+		reason = fmt.Sprintf("running data at %d, the code ends at %d", v.Address, v.Limit)
+	default:
+		reason = string(v.Rule)
+	}
+
+	return fmt.Sprintf("policy violation at %d: %s", v.InstructionPointer, reason)
+}
+
+// SetPolicy limits what the computer is allowed to do, nil removes every limit.
+func (ic *Computer) SetPolicy(policy *Policy) {
+	ic.policy = policy
+	ic.Memory.policy = policy
+}
+
+func (ic *Computer) Policy() *Policy {
+	return ic.policy
+}
+
+func (p *Policy) checkWrite(address AddressLocation) error {
+	if p == nil {
+		return nil
+	}
+
+	if p.MaxMemory > 0 && address >= p.MaxMemory {
+		return &PolicyViolation{Rule: MaxMemoryRule, Address: address, Limit: int64(p.MaxMemory)}
+	}
+
+	if p.ProtectCode && address >= 0 && address < p.CodeSize {
+		return &PolicyViolation{Rule: CodeWriteRule, Address: address, Limit: int64(p.CodeSize)}
+	}
+
+	return nil
+}
+
+// Check the computer is allowed to run the next instruction.
+func (p *Policy) checkStep(computer *Computer) error {
+	if p == nil {
+		return nil
+	}
+
+	address := computer.instructionPointer
+
+	violation := func(rule Rule, limit int64) error {
+		return &PolicyViolation{Rule: rule, InstructionPointer: address, Address: address, Limit: limit}
+	}
+
+	switch {
+	case p.MaxInstructions > 0 && computer.steps >= p.MaxInstructions:
+		return violation(MaxInstructionsRule, p.MaxInstructions)
+	case p.MaxMemory > 0 && address >= p.MaxMemory:
+		return violation(MaxMemoryRule, int64(p.MaxMemory))
+	case p.ForbidDataExecution && p.CodeSize > 0 && address >= p.CodeSize:
+		return violation(DataExecutionRule, int64(p.CodeSize))
+	}
+
+	return nil
+}
+
+func (p *Policy) checkOutput(computer *Computer) error {
+	if p == nil || p.MaxOutputs == 0 || computer.outputs < p.MaxOutputs {
+		return nil
+	}
+
+	return &PolicyViolation{
+		Rule:               MaxOutputsRule,
+		InstructionPointer: computer.instructionPointer,
+		Limit:              p.MaxOutputs,
+	}
+}
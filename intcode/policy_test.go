@@ -0,0 +1,88 @@
+package intcode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Step until the computer halts or fails.
+func runUntilError(computer *Computer) error {
+	for {
+		opcode, err := computer.Step()
+		if err != nil {
+			return err
+		}
+
+		if opcode == HALT {
+			return nil
+		}
+	}
+}
+
+func TestPolicyMaxInstructions(t *testing.T) {
+	// Loop forever
+	computer := NewComputer([]AddressValue{1105, 1, 0})
+	computer.SetPolicy(&Policy{MaxInstructions: 10})
+
+	err := runUntilError(computer)
+
+	violation, ok := err.(*PolicyViolation)
+	assert.True(t, ok)
+	assert.Equal(t, MaxInstructionsRule, violation.Rule)
+	assert.Equal(t, "policy violation at 0: instruction limit of 10 reached", err.Error())
+	assert.Equal(t, int64(10), computer.Steps())
+}
+
+func TestPolicyMaxOutputs(t *testing.T) {
+	computer := NewComputer([]AddressValue{104, 1, 104, 2, 104, 3, 99})
+	computer.Output = make(chan AddressValue, 3)
+	computer.SetPolicy(&Policy{MaxOutputs: 2})
+
+	assert.Equal(t, "policy violation at 4: output limit of 2 reached", runUntilError(computer).Error())
+	assert.Equal(t, 2, len(computer.Output))
+}
+
+func TestPolicyProtectCode(t *testing.T) {
+	// Overwrite the halt at the end of the code
+	computer := NewComputer([]AddressValue{1101, 1, 1, 4, 99, 0})
+	computer.SetPolicy(&Policy{CodeSize: 5, ProtectCode: true})
+
+	err := runUntilError(computer)
+
+	violation, ok := err.(*PolicyViolation)
+	assert.True(t, ok)
+	assert.Equal(t, &PolicyViolation{Rule: CodeWriteRule, InstructionPointer: 0, Address: 4, Limit: 5}, violation)
+	assert.Equal(t, AddressValue(99), computer.Memory.Get(4))
+}
+
+func TestPolicyForbidDataExecution(t *testing.T) {
+	// Jump into the data after the halt
+	computer := NewComputer([]AddressValue{1105, 1, 4, 99, 99})
+	computer.SetPolicy(&Policy{CodeSize: 4, ForbidDataExecution: true})
+
+	assert.Equal(t, "policy violation at 4: running data at 4, the code ends at 4", runUntilError(computer).Error())
+}
+
+func TestPolicyForbidDataExecutionWithoutCodeSize(t *testing.T) {
+	// Without a code size there is nothing to tell code and data apart
+	computer := NewComputer([]AddressValue{1101, 1, 1, 5, 99, 0})
+	computer.SetPolicy(&Policy{ForbidDataExecution: true})
+
+	assert.Nil(t, runUntilError(computer))
+}
+
+func TestPolicyMaxMemory(t *testing.T) {
+	computer := NewComputer([]AddressValue{1101, 1, 1, 9, 99, 0, 0, 0, 0, 0})
+	computer.SetPolicy(&Policy{MaxMemory: 8})
+
+	assert.Equal(t, "policy violation at 0: address 9 is past the memory limit of 8", runUntilError(computer).Error())
+}
+
+func TestNoPolicy(t *testing.T) {
+	computer := NewComputer([]AddressValue{1101, 1, 1, 5, 99, 0})
+	assert.Nil(t, computer.Policy())
+
+	assert.Nil(t, runUntilError(computer))
+	assert.Equal(t, AddressValue(2), computer.Memory.Get(5))
+}
@@ -0,0 +1,73 @@
+package intcode
+
+import "fmt"
+
+// Profile is the set of features the puzzles had introduced by a certain day.
+type Profile struct {
+	Name    string
+	Opcodes []AddressValue
+	Modes   []Mode
+}
+
+// Day2Profile only knows how to do arithmetic, every parameter is a position.
+var Day2Profile = Profile{
+	Name:    "day-2",
+	Opcodes: []AddressValue{ADD, MULTIPLY, HALT},
+	Modes:   []Mode{Position},
+}
+
+// Day5Profile adds IO, jumps, comparisons and immediate mode parameters.
+var Day5Profile = Profile{
+	Name:    "day-5",
+	Opcodes: []AddressValue{ADD, MULTIPLY, INPUT, OUTPUT, JUMPIFTRUE, JUMPIFFALSE, LESSTHAN, EQUALS, HALT},
+	Modes:   []Mode{Position, Immediate},
+}
+
+// Day9Profile adds relative mode parameters and the opcode that moves the relative base.
+var Day9Profile = Profile{
+	Name: "day-9",
+	Opcodes: []AddressValue{
+		ADD, MULTIPLY, INPUT, OUTPUT, JUMPIFTRUE, JUMPIFFALSE, LESSTHAN, EQUALS, ADJUSTRELATIVEBASE, HALT,
+	},
+	Modes: []Mode{Position, Immediate, Relative},
+}
+
+// Profiles lists every known profile, oldest first.
+var Profiles = []Profile{Day2Profile, Day5Profile, Day9Profile}
+
+// LookupProfile finds a profile by name.
+func LookupProfile(name string) (Profile, error) {
+	for _, profile := range Profiles {
+		if profile.Name == name {
+			return profile, nil
+		}
+	}
+
+	return Profile{}, fmt.Errorf("unknown profile: %s", name)
+}
+
+// InstructionSet builds the instruction set with exactly the profile's features.
+func (p Profile) InstructionSet() *InstructionSet {
+	set, err := DefaultInstructionSet().Subset(p.Opcodes...)
+	if err != nil {
+		// The profiles are only ever built from the standard opcodes
+		panic(err)
+	}
+
+	set.profile = p.Name
+	set.modes = make(map[Mode]bool, len(p.Modes))
+
+	for _, mode := range p.Modes {
+		set.modes[mode] = true
+	}
+
+	return set
+}
+
+// NewComputerWithProfile creates a computer that refuses anything outside of a profile.
+func NewComputerWithProfile(initialMemory []AddressValue, profile Profile) *Computer {
+	computer := NewComputer(initialMemory)
+	computer.SetInstructionSet(profile.InstructionSet())
+
+	return computer
+}
@@ -0,0 +1,89 @@
+package intcode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupProfile(t *testing.T) {
+	profile, err := LookupProfile("day-5")
+	assert.Nil(t, err)
+	assert.Equal(t, Day5Profile.Name, profile.Name)
+
+	_, err = LookupProfile("day-50")
+	assert.Equal(t, "unknown profile: day-50", err.Error())
+}
+
+func TestProfileInstructionSet(t *testing.T) {
+	set := Day2Profile.InstructionSet()
+
+	assert.Len(t, set.Opcodes(), 3)
+	assert.True(t, set.AllowsMode(Position))
+	assert.False(t, set.AllowsMode(Immediate))
+
+	// Changing a profile's set does not change the profile
+	set.Remove(ADD)
+	assert.Len(t, Day2Profile.InstructionSet().Opcodes(), 3)
+}
+
+func TestDay2Profile(t *testing.T) {
+	computer := NewComputerWithProfile([]AddressValue{1, 9, 10, 3, 2, 3, 11, 0, 99, 30, 40, 50}, Day2Profile)
+
+	computer.Run()
+
+	assert.Equal(t, AddressValue(3500), computer.Memory.Get(0))
+}
+
+func TestDay2ProfileRejectsNewerOpcodes(t *testing.T) {
+	computer := NewComputerWithProfile([]AddressValue{3, 0, 99}, Day2Profile)
+
+	_, err := computer.Step()
+
+	assert.Equal(t, "opcode 3 (INPUT) is not available in the day-2 profile", err.Error())
+}
+
+func TestDay2ProfileRejectsImmediateMode(t *testing.T) {
+	computer := NewComputerWithProfile([]AddressValue{1101, 1, 1, 0, 99}, Day2Profile)
+
+	_, err := computer.Step()
+
+	assert.Equal(t, "parameter mode 1 (immediate) is not available in the day-2 profile", err.Error())
+}
+
+func TestDay5ProfileRejectsUnknownOpcodes(t *testing.T) {
+	computer := NewComputerWithProfile([]AddressValue{50, 0, 99}, Day5Profile)
+
+	_, err := computer.Step()
+
+	assert.Equal(t, "invalid opcode: 50", err.Error())
+}
+
+func TestModeString(t *testing.T) {
+	assert.Equal(t, "position", Position.String())
+	assert.Equal(t, "immediate", Immediate.String())
+	assert.Equal(t, "mode(7)", Mode(7).String())
+}
+
+func TestDay5ProfileRejectsRelativeMode(t *testing.T) {
+	computer := NewComputerWithProfile([]AddressValue{204, 0, 99}, Day5Profile)
+
+	_, err := computer.Step()
+
+	assert.Equal(t, "parameter mode 2 (relative) is not available in the day-5 profile", err.Error())
+
+	computer = NewComputerWithProfile([]AddressValue{109, 1, 99}, Day5Profile)
+
+	_, err = computer.Step()
+
+	assert.Equal(t, "opcode 9 (ADJUST-RELATIVE-BASE) is not available in the day-5 profile", err.Error())
+}
+
+func TestDay9Profile(t *testing.T) {
+	computer := NewComputerWithProfile([]AddressValue{109, 5, 22201, 0, 1, 1000, 99}, Day9Profile)
+
+	computer.Run()
+
+	// The two values after the base are added together past the end of the program
+	assert.Equal(t, AddressValue(1000+99), computer.Memory.Get(1005))
+}
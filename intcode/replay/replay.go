@@ -0,0 +1,189 @@
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/intcode/scheduler"
+)
+
+// Kind is the direction a value moved in.
+type Kind string
+
+const (
+	Input  Kind = "input"
+	Output Kind = "output"
+)
+
+// Event is a single value read or written by a program.
+type Event struct {
+	// The index of the instruction that moved the value
+	Step  int64                `json:"step"`
+	Kind  Kind                 `json:"kind"`
+	Value intcode.AddressValue `json:"value"`
+}
+
+func (e Event) String() string {
+	return fmt.Sprintf("%s %d at step %d", e.Kind, e.Value, e.Step)
+}
+
+// Log is every value a program read and wrote, in order.
+type Log struct {
+	Events []Event `json:"events"`
+}
+
+// Inputs lists the values the program read.
+func (l *Log) Inputs() []intcode.AddressValue {
+	var inputs []intcode.AddressValue
+
+	for _, event := range l.Events {
+		if event.Kind == Input {
+			inputs = append(inputs, event.Value)
+		}
+	}
+
+	return inputs
+}
+
+// Outputs lists the values the program wrote.
+func (l *Log) Outputs() []intcode.AddressValue {
+	var outputs []intcode.AddressValue
+
+	for _, event := range l.Events {
+		if event.Kind == Output {
+			outputs = append(outputs, event.Value)
+		}
+	}
+
+	return outputs
+}
+
+// Save the log as JSON.
+func (l *Log) Save(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(l)
+}
+
+// Load a log saved with Save.
+func Load(r io.Reader) (*Log, error) {
+	var l Log
+
+	if err := json.NewDecoder(r).Decode(&l); err != nil {
+		return nil, fmt.Errorf("loading replay log: %w", err)
+	}
+
+	return &l, nil
+}
+
+// Record every value the computer reads and writes from now on.
+//
+// It works by wrapping the INPUT and OUTPUT opcodes of the computer's instruction set.
+func Record(computer *intcode.Computer) *Log {
+	l := &Log{}
+	set := computer.InstructionSet().Copy()
+
+	if input, ok := set.Get(intcode.INPUT); ok {
+		execute := input.Execute
+		input.Execute = func(computer *intcode.Computer, operation intcode.Opcode, parameters []intcode.AddressValue) error {
+			if err := execute(computer, operation, parameters); err != nil {
+				return err
+			}
+
+			l.Events = append(l.Events, Event{
+				Step:  computer.Steps(),
+				Kind:  Input,
+				Value: computer.Memory.Get(intcode.AddressLocation(parameters[0])),
+			})
+
+			return nil
+		}
+
+		_ = set.Override(input)
+	}
+
+	if output, ok := set.Get(intcode.OUTPUT); ok {
+		execute := output.Execute
+		output.Execute = func(computer *intcode.Computer, operation intcode.Opcode, parameters []intcode.AddressValue) error {
+			if err := execute(computer, operation, parameters); err != nil {
+				return err
+			}
+
+			l.Events = append(l.Events, Event{Step: computer.Steps(), Kind: Output, Value: parameters[0]})
+
+			return nil
+		}
+
+		_ = set.Override(output)
+	}
+
+	computer.SetInstructionSet(set)
+
+	return l
+}
+
+// MismatchError is returned when a replayed program does something different from the recording.
+type MismatchError struct {
+	// Position of the first event that differs
+	Index    int
+	Expected *Event
+	Actual   *Event
+}
+
+func (e *MismatchError) Error() string {
+	describe := func(event *Event) string {
+		if event == nil {
+			return "nothing"
+		}
+
+		return event.String()
+	}
+
+	return fmt.Sprintf(
+		"replay diverged at event %d: expected %s, got %s",
+		e.Index,
+		describe(e.Expected),
+		describe(e.Actual),
+	)
+}
+
+// Compare two logs, returning the first place they differ.
+func Compare(expected *Log, actual *Log) *MismatchError {
+	for i := 0; i < len(expected.Events) || i < len(actual.Events); i++ {
+		var want, got *Event
+
+		if i < len(expected.Events) {
+			want = &expected.Events[i]
+		}
+
+		if i < len(actual.Events) {
+			got = &actual.Events[i]
+		}
+
+		if want == nil || got == nil || *want != *got {
+			return &MismatchError{Index: i, Expected: want, Actual: got}
+		}
+	}
+
+	return nil
+}
+
+// Replay runs a computer with the recorded inputs and checks it does exactly what was recorded.
+func Replay(computer *intcode.Computer, recorded *Log) error {
+	actual := Record(computer)
+
+	runner := scheduler.New()
+	runner.Logger = computer.Logger()
+	runner.Add(computer).Send(recorded.Inputs()...)
+
+	err := runner.Run()
+
+	if mismatch := Compare(recorded, actual); mismatch != nil {
+		return mismatch
+	}
+
+	return err
+}
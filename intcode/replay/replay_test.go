@@ -0,0 +1,153 @@
+package replay
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/intcode/scheduler"
+	"github.com/giodamelio/aoc-2020-go/intcode/transpiler/programs"
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden replay logs")
+
+func loadProgram(t *testing.T, path string) []intcode.AddressValue {
+	rawProgram, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+
+	program, err := intcode.ParseInput(string(rawProgram))
+	assert.Nil(t, err)
+
+	return program
+}
+
+// Run a computer to completion with some inputs, recording everything.
+func record(t *testing.T, computer *intcode.Computer, inputs ...intcode.AddressValue) *Log {
+	recorded := Record(computer)
+
+	runner := scheduler.New()
+	runner.Add(computer).Send(inputs...)
+	assert.Nil(t, runner.Run())
+
+	return recorded
+}
+
+// Take an input, double it and output the result.
+var double = []intcode.AddressValue{3, 9, 102, 2, 9, 9, 4, 9, 99, 0}
+
+func TestRecord(t *testing.T) {
+	recorded := record(t, intcode.NewComputer(double), 21)
+
+	assert.Equal(t, []Event{
+		{Step: 0, Kind: Input, Value: 21},
+		{Step: 2, Kind: Output, Value: 42},
+	}, recorded.Events)
+	assert.Equal(t, []intcode.AddressValue{21}, recorded.Inputs())
+	assert.Equal(t, []intcode.AddressValue{42}, recorded.Outputs())
+}
+
+// Compiled programs have to notice the recording and run it through the interpreter.
+func TestRecordCompiled(t *testing.T) {
+	computer := intcode.NewComputer(loadProgram(t, "../../solutions/day-05/input.txt"))
+	recorded := Record(computer)
+
+	computer.Input = make(chan intcode.AddressValue, 1)
+	computer.Input <- 5
+	computer.Output = make(chan intcode.AddressValue, 1)
+
+	assert.Nil(t, programs.Day05(computer))
+
+	file, err := os.Open("testdata/day05-part2.json")
+	assert.Nil(t, err)
+	defer file.Close()
+
+	golden, err := Load(file)
+	assert.Nil(t, err)
+
+	assert.Nil(t, Compare(golden, recorded))
+	assert.Equal(t, []intcode.AddressValue{12648139}, recorded.Outputs())
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	recorded := record(t, intcode.NewComputer(double), 21)
+
+	var buffer bytes.Buffer
+
+	assert.Nil(t, recorded.Save(&buffer))
+
+	loaded, err := Load(&buffer)
+	assert.Nil(t, err)
+	assert.Equal(t, recorded, loaded)
+
+	_, err = Load(bytes.NewBufferString("nope"))
+	assert.Equal(t, "loading replay log: invalid character 'o' in literal null (expecting 'u')", err.Error())
+}
+
+func TestReplay(t *testing.T) {
+	recorded := record(t, intcode.NewComputer(double), 21)
+
+	assert.Nil(t, Replay(intcode.NewComputer(double), recorded))
+}
+
+func TestReplayMismatch(t *testing.T) {
+	recorded := record(t, intcode.NewComputer(double), 21)
+
+	// Triple instead of double
+	changed := intcode.NewComputer(double)
+	changed.Memory.Set(3, 3)
+
+	assert.Equal(
+		t,
+		"replay diverged at event 1: expected output 42 at step 2, got output 63 at step 2",
+		Replay(changed, recorded).Error(),
+	)
+
+	// Output one extra value
+	extra := intcode.NewComputer([]intcode.AddressValue{3, 11, 102, 2, 11, 11, 4, 11, 104, 7, 99, 0})
+
+	assert.Equal(
+		t,
+		"replay diverged at event 2: expected nothing, got output 7 at step 3",
+		Replay(extra, recorded).Error(),
+	)
+}
+
+func TestGolden(t *testing.T) {
+	golden := []struct {
+		file    string
+		program string
+		profile intcode.Profile
+		inputs  []intcode.AddressValue
+	}{
+		{"day05-part1.json", "../../solutions/day-05/input.txt", intcode.Day5Profile, []intcode.AddressValue{1}},
+		{"day05-part2.json", "../../solutions/day-05/input.txt", intcode.Day5Profile, []intcode.AddressValue{5}},
+		{"day07-amplifier.json", "../../solutions/day-07/input.txt", intcode.Day5Profile, []intcode.AddressValue{4, 0}},
+	}
+
+	for _, g := range golden {
+		path := filepath.Join("testdata", g.file)
+		program := loadProgram(t, g.program)
+
+		if *update {
+			var buffer bytes.Buffer
+
+			recorded := record(t, intcode.NewComputerWithProfile(program, g.profile), g.inputs...)
+			assert.Nil(t, recorded.Save(&buffer))
+			assert.Nil(t, ioutil.WriteFile(path, buffer.Bytes(), 0o644))
+		}
+
+		file, err := os.Open(path)
+		assert.Nil(t, err)
+
+		recorded, err := Load(file)
+		assert.Nil(t, err)
+		file.Close()
+
+		assert.Nil(t, Replay(intcode.NewComputerWithProfile(program, g.profile), recorded), g.file)
+	}
+}
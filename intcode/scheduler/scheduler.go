@@ -0,0 +1,258 @@
+package scheduler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/logging"
+	"github.com/rs/zerolog"
+)
+
+// Process is a computer being run by a scheduler.
+type Process struct {
+	Computer *intcode.Computer
+	// Called with every value the computer outputs
+	OnOutput func(value intcode.AddressValue) error
+	// Called when the computer wants input and none is queued. If it returns a value the
+	// computer reads it and its turn ends, otherwise the computer waits.
+	OnEmptyInput func() (intcode.AddressValue, bool)
+	queue        []intcode.AddressValue
+	waiting      bool
+	halted       bool
+}
+
+// Send values to the input of the process.
+func (p *Process) Send(values ...intcode.AddressValue) {
+	p.queue = append(p.queue, values...)
+}
+
+// Queued is the number of input values that have not been read yet.
+func (p *Process) Queued() int {
+	return len(p.queue)
+}
+
+// Waiting reports if the process ended its last turn waiting for input.
+func (p *Process) Waiting() bool {
+	return p.waiting
+}
+
+// Halted reports if the computer has halted.
+func (p *Process) Halted() bool {
+	return p.halted
+}
+
+// Blocked describes a process that cannot make progress.
+type Blocked struct {
+	Name               string
+	InstructionPointer intcode.AddressLocation
+	Instruction        string
+}
+
+// DeadlockError is returned when every running process is waiting for input that will never come.
+type DeadlockError struct {
+	Blocked []Blocked
+}
+
+func (e *DeadlockError) Error() string {
+	descriptions := make([]string, len(e.Blocked))
+
+	for i, blocked := range e.Blocked {
+		descriptions[i] = fmt.Sprintf(
+			"%s is waiting for input at %d (%s)",
+			blocked.Name,
+			blocked.InstructionPointer,
+			blocked.Instruction,
+		)
+	}
+
+	return fmt.Sprintf("deadlock: %s", strings.Join(descriptions, ", "))
+}
+
+// Scheduler runs many computers on a single goroutine, taking turns in the order they were added.
+//
+// Since nothing runs concurrently the same programs and inputs always run in the same order.
+type Scheduler struct {
+	processes []*Process
+	// How many instructions a computer runs before the next one gets a turn, at least 1
+	Quantum int
+	// If the last round ran any instructions
+	progress bool
+	// Where the scheduler logs to, the computers have their own loggers
+	Logger zerolog.Logger
+}
+
+// New creates a scheduler with nothing to run.
+func New() *Scheduler {
+	return &Scheduler{Quantum: 1000, Logger: zerolog.Nop()}
+}
+
+// Add a computer to the scheduler.
+func (s *Scheduler) Add(computer *intcode.Computer) *Process {
+	// Buffered channels let a single goroutine drive the computer one step at a time
+	computer.Input = make(chan intcode.AddressValue, 1)
+	computer.Output = make(chan intcode.AddressValue, 1)
+	computer.State = "running"
+
+	process := &Process{Computer: computer}
+	s.processes = append(s.processes, process)
+
+	return process
+}
+
+// Processes lists everything the scheduler runs, in scheduling order.
+func (s *Scheduler) Processes() []*Process {
+	return s.processes
+}
+
+// Give a process a turn, returning how many instructions it ran.
+func (s *Scheduler) turn(process *Process) (int, error) {
+	computer := process.Computer
+	process.waiting = false
+
+	for steps := 0; steps < s.Quantum; steps++ {
+		opcode, err := computer.NextOpcode()
+		if err != nil {
+			return steps, fmt.Errorf("%s: %w", computer.Name, err)
+		}
+
+		endTurn := false
+
+		if opcode == intcode.INPUT {
+			switch {
+			case len(process.queue) > 0:
+				computer.Input <- process.queue[0]
+				process.queue = process.queue[1:]
+			case process.OnEmptyInput != nil:
+				value, ok := process.OnEmptyInput()
+				if !ok {
+					process.waiting = true
+
+					return steps, nil
+				}
+
+				computer.Input <- value
+				endTurn = true
+			default:
+				process.waiting = true
+
+				return steps, nil
+			}
+		}
+
+		_, err = computer.Step()
+		if err != nil {
+			return steps, fmt.Errorf("%s: %w", computer.Name, err)
+		}
+
+		switch opcode {
+		case intcode.OUTPUT:
+			value := <-computer.Output
+
+			if process.OnOutput != nil {
+				if err := process.OnOutput(value); err != nil {
+					return steps + 1, fmt.Errorf("%s: %w", computer.Name, err)
+				}
+			}
+		case intcode.HALT:
+			process.halted = true
+			computer.Halt()
+
+			return steps + 1, nil
+		}
+
+		if endTurn {
+			return steps + 1, nil
+		}
+	}
+
+	return s.Quantum, nil
+}
+
+// Round gives every running process a single turn.
+func (s *Scheduler) Round() error {
+	// A turn of no instructions would look exactly like a deadlock
+	if s.Quantum < 1 {
+		return fmt.Errorf("quantum must be at least 1: %d", s.Quantum)
+	}
+
+	s.progress = false
+
+	for _, process := range s.processes {
+		if process.halted {
+			continue
+		}
+
+		steps, err := s.turn(process)
+		if err != nil {
+			return err
+		}
+
+		if steps > 0 {
+			s.progress = true
+		}
+	}
+
+	return nil
+}
+
+// Done reports if every process has halted.
+func (s *Scheduler) Done() bool {
+	for _, process := range s.processes {
+		if !process.halted {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Deadlock describes the blocked processes if the last round could not run anything.
+func (s *Scheduler) Deadlock() *DeadlockError {
+	if s.progress || s.Done() {
+		return nil
+	}
+
+	deadlock := &DeadlockError{}
+
+	for _, process := range s.processes {
+		if process.halted || !process.waiting {
+			continue
+		}
+
+		computer := process.Computer
+		instruction := "unknown"
+
+		if opcode, err := computer.NextOpcode(); err == nil {
+			if definition, ok := computer.InstructionSet().Get(opcode); ok {
+				instruction = definition.Name
+			}
+		}
+
+		deadlock.Blocked = append(deadlock.Blocked, Blocked{
+			Name:               computer.Name,
+			InstructionPointer: computer.InstructionPointer(),
+			Instruction:        instruction,
+		})
+	}
+
+	return deadlock
+}
+
+// Run rounds until every process halts, failing if they get stuck waiting on each other.
+func (s *Scheduler) Run() error {
+	for !s.Done() {
+		if err := s.Round(); err != nil {
+			return err
+		}
+
+		if deadlock := s.Deadlock(); deadlock != nil {
+			logger := logging.Subsystem(s.Logger, logging.Scheduler)
+			logger.Error().Err(deadlock).Msg("[SCHEDULER] Deadlock")
+
+			return deadlock
+		}
+	}
+
+	return nil
+}
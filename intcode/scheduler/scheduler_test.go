@@ -0,0 +1,140 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/stretchr/testify/assert"
+)
+
+// Output a counter from 1 to 3, then halt.
+var counter = []intcode.AddressValue{
+	1001, 14, 1, 14, // m[14] = m[14] + 1
+	4, 14, // output(m[14])
+	1007, 14, 3, 15, // m[15] = m[14] < 3
+	1005, 15, 0, // if m[15] != 0 goto 0
+	99,
+	0, 0,
+}
+
+// Take an input, double it and output the result.
+var double = []intcode.AddressValue{3, 9, 102, 2, 9, 9, 4, 9, 99, 0}
+
+func TestRoundRobin(t *testing.T) {
+	s := New()
+	s.Quantum = 3
+
+	var order []string
+
+	for _, name := range []string{"a", "b"} {
+		computer := intcode.NewComputer(counter)
+		computer.Name = name
+		process := s.Add(computer)
+
+		process.OnOutput = func(value intcode.AddressValue) error {
+			order = append(order, computer.Name)
+
+			return nil
+		}
+	}
+
+	assert.Nil(t, s.Run())
+	assert.Equal(t, []string{"a", "b", "a", "b", "a", "b"}, order)
+	assert.True(t, s.Done())
+
+	for _, process := range s.Processes() {
+		assert.Equal(t, "halted", process.Computer.State)
+	}
+}
+
+func TestInvalidQuantum(t *testing.T) {
+	s := New()
+	s.Quantum = 0
+	s.Add(intcode.NewComputer(counter))
+
+	assert.Equal(t, "quantum must be at least 1: 0", s.Run().Error())
+}
+
+func TestPassingValues(t *testing.T) {
+	s := New()
+
+	first := s.Add(intcode.NewComputer(double))
+	second := s.Add(intcode.NewComputer(double))
+
+	var result intcode.AddressValue
+
+	first.OnOutput = func(value intcode.AddressValue) error {
+		second.Send(value)
+
+		return nil
+	}
+	second.OnOutput = func(value intcode.AddressValue) error {
+		result = value
+
+		return nil
+	}
+
+	// The second computer is scheduled before it has anything to read
+	first.Send(5)
+
+	assert.Nil(t, s.Run())
+	assert.Equal(t, intcode.AddressValue(20), result)
+}
+
+func TestOnEmptyInput(t *testing.T) {
+	s := New()
+	process := s.Add(intcode.NewComputer(double))
+
+	var result intcode.AddressValue
+
+	process.OnEmptyInput = func() (intcode.AddressValue, bool) {
+		return -1, true
+	}
+	process.OnOutput = func(value intcode.AddressValue) error {
+		result = value
+
+		return nil
+	}
+
+	assert.Nil(t, s.Run())
+	assert.Equal(t, intcode.AddressValue(-2), result)
+}
+
+func TestDeadlock(t *testing.T) {
+	s := New()
+
+	first := intcode.NewComputer(double)
+	first.Name = "first"
+	second := intcode.NewComputer(append([]intcode.AddressValue{104, 1}, double...))
+	second.Name = "second"
+	third := intcode.NewComputer(double)
+	third.Name = "third"
+
+	s.Add(first)
+	s.Add(second)
+	s.Add(third).Send(1)
+
+	err := s.Run()
+	deadlock, ok := err.(*DeadlockError)
+
+	assert.True(t, ok)
+	assert.Equal(t, []Blocked{
+		{Name: "first", InstructionPointer: 0, Instruction: "INPUT"},
+		{Name: "second", InstructionPointer: 2, Instruction: "INPUT"},
+	}, deadlock.Blocked)
+	assert.Equal(
+		t,
+		"deadlock: first is waiting for input at 0 (INPUT), second is waiting for input at 2 (INPUT)",
+		err.Error(),
+	)
+}
+
+func TestStepError(t *testing.T) {
+	s := New()
+
+	computer := intcode.NewComputer([]intcode.AddressValue{50})
+	computer.Name = "broken"
+	s.Add(computer)
+
+	assert.Equal(t, "broken: invalid opcode: 50", s.Run().Error())
+}
@@ -0,0 +1,139 @@
+package intcode
+
+import "fmt"
+
+// SelfModification decides what happens when a program writes over code it has already run.
+type SelfModification int
+
+const (
+	// IgnoreSelfModification turns tracking off.
+	IgnoreSelfModification SelfModification = iota
+	// CountSelfModification records the writes without saying anything.
+	CountSelfModification
+	// LogSelfModification records the writes and logs a warning for each.
+	LogSelfModification
+	// FaultSelfModification stops the computer with a SelfModificationError.
+	FaultSelfModification
+)
+
+// CodeWrite is a single write to an address that has run as code.
+type CodeWrite struct {
+	InstructionPointer AddressLocation
+	Address            AddressLocation
+	Old                AddressValue
+	New                AddressValue
+}
+
+// SelfModificationError is returned when a program changes its own code with faulting turned on.
+type SelfModificationError struct {
+	CodeWrite
+}
+
+func (e *SelfModificationError) Error() string {
+	return fmt.Sprintf(
+		"self modifying code at %d: wrote %d over %d at %d, which has already run as code",
+		e.InstructionPointer,
+		e.New,
+		e.Old,
+		e.Address,
+	)
+}
+
+// Remembers which addresses have run as code and what was written over them.
+type codeTracker struct {
+	computer *Computer
+	mode     SelfModification
+	executed map[AddressLocation]bool
+	writes   []CodeWrite
+	// Told about every write to code, like caches of decoded instructions
+	listeners []func(CodeWrite)
+}
+
+// Mark an instruction and its parameters as code.
+func (t *codeTracker) execute(address AddressLocation, length int) {
+	if t == nil {
+		return
+	}
+
+	for i := 0; i < length; i++ {
+		t.executed[address+AddressLocation(i)] = true
+	}
+}
+
+func (t *codeTracker) write(address AddressLocation, old AddressValue, value AddressValue) error {
+	if t == nil || !t.executed[address] {
+		return nil
+	}
+
+	write := CodeWrite{
+		InstructionPointer: t.computer.instructionPointer,
+		Address:            address,
+		Old:                old,
+		New:                value,
+	}
+
+	if t.mode == FaultSelfModification {
+		return &SelfModificationError{write}
+	}
+
+	t.writes = append(t.writes, write)
+
+	if t.mode == LogSelfModification {
+		t.computer.log.
+			Warn().
+			Str("name", t.computer.Name).
+			Int64("instructionPointer", int64(write.InstructionPointer)).
+			Int64("address", int64(address)).
+			Int64("old", int64(old)).
+			Int64("new", int64(value)).
+			Msg("[COMPUTER] Self modifying code")
+	}
+
+	for _, listener := range t.listeners {
+		listener(write)
+	}
+
+	return nil
+}
+
+// TrackSelfModification watches for the program writing over its own code.
+//
+// Only code run after tracking starts counts.
+func (ic *Computer) TrackSelfModification(mode SelfModification) {
+	if mode == IgnoreSelfModification {
+		ic.code = nil
+		ic.Memory.code = nil
+
+		return
+	}
+
+	if ic.code == nil {
+		ic.code = &codeTracker{computer: ic, executed: make(map[AddressLocation]bool)}
+		ic.Memory.code = ic.code
+	}
+
+	ic.code.mode = mode
+}
+
+// CodeWrites lists every write over code that has been seen.
+func (ic *Computer) CodeWrites() []CodeWrite {
+	if ic.code == nil {
+		return nil
+	}
+
+	return ic.code.writes
+}
+
+// OnCodeWrite calls listener whenever the program writes over its own code, tracking has to be on.
+func (ic *Computer) OnCodeWrite(listener func(CodeWrite)) {
+	if ic.code != nil {
+		ic.code.listeners = append(ic.code.listeners, listener)
+	}
+}
+
+// RequiresInterpreter reports if a feature is on that only the interpreter supports.
+//
+// Compiled code only knows the standard opcodes, so any other instruction set needs the interpreter too.
+func (ic *Computer) RequiresInterpreter() bool {
+	return ic.policy != nil || ic.code != nil || !ic.instructionSet.standard
+}
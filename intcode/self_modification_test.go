@@ -0,0 +1,61 @@
+package intcode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Add 1 + 1 into the first instruction, then halt.
+var overwritesItself = []AddressValue{1101, 1, 1, 0, 99}
+
+func TestTrackSelfModificationCount(t *testing.T) {
+	computer := NewComputer(overwritesItself)
+	computer.TrackSelfModification(CountSelfModification)
+
+	var heard []CodeWrite
+
+	computer.OnCodeWrite(func(write CodeWrite) {
+		heard = append(heard, write)
+	})
+
+	assert.Nil(t, runUntilError(computer))
+
+	expected := []CodeWrite{{InstructionPointer: 0, Address: 0, Old: 1101, New: 2}}
+	assert.Equal(t, expected, computer.CodeWrites())
+	assert.Equal(t, expected, heard)
+}
+
+func TestTrackSelfModificationFault(t *testing.T) {
+	computer := NewComputer(overwritesItself)
+	computer.TrackSelfModification(FaultSelfModification)
+
+	err := runUntilError(computer)
+
+	assert.Equal(t, "self modifying code at 0: wrote 2 over 1101 at 0, which has already run as code", err.Error())
+	assert.Equal(t, AddressValue(1101), computer.Memory.Get(0))
+	assert.Empty(t, computer.CodeWrites())
+}
+
+func TestTrackSelfModificationData(t *testing.T) {
+	// Writes to addresses that never ran are not code changes
+	computer := NewComputer([]AddressValue{1101, 1, 1, 5, 99, 0})
+	computer.TrackSelfModification(FaultSelfModification)
+
+	assert.Nil(t, runUntilError(computer))
+	assert.Empty(t, computer.CodeWrites())
+}
+
+func TestTrackSelfModificationOff(t *testing.T) {
+	computer := NewComputer(overwritesItself)
+	assert.False(t, computer.RequiresInterpreter())
+
+	computer.TrackSelfModification(LogSelfModification)
+	assert.True(t, computer.RequiresInterpreter())
+
+	computer.TrackSelfModification(IgnoreSelfModification)
+	assert.False(t, computer.RequiresInterpreter())
+
+	assert.Nil(t, runUntilError(computer))
+	assert.Nil(t, computer.CodeWrites())
+}
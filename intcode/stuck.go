@@ -0,0 +1,123 @@
+package intcode
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// StuckPolicy decides what a computer does when its input or output is not serviced in time.
+type StuckPolicy int
+
+const (
+	// Fault stops the computer with a StuckError.
+	Fault StuckPolicy = iota
+	// Drop throws away the output nobody is reading, or halts the computer if no input comes.
+	Drop
+)
+
+func (p StuckPolicy) String() string {
+	switch p {
+	case Fault:
+		return "fault"
+	case Drop:
+		return "drop"
+	default:
+		return fmt.Sprintf("policy(%d)", int(p))
+	}
+}
+
+// StuckError is returned when a computer waits too long to send or receive a value.
+type StuckError struct {
+	Name               string
+	InstructionPointer AddressLocation
+	Instruction        string
+	// Either "send" or "receive"
+	Direction string
+	Timeout   time.Duration
+}
+
+func (e *StuckError) Error() string {
+	return fmt.Sprintf(
+		"computer %s stuck trying to %s at %d (%s) for %s",
+		e.Name,
+		e.Direction,
+		e.InstructionPointer,
+		e.Instruction,
+		e.Timeout,
+	)
+}
+
+// Returned by INPUT when the computer should halt instead of waiting any longer.
+var errInputDropped = errors.New("input dropped")
+
+// SetIOTimeout limits how long INPUT and OUTPUT wait on the channels, zero waits forever.
+func (ic *Computer) SetIOTimeout(timeout time.Duration, policy StuckPolicy) {
+	ic.ioTimeout = timeout
+	ic.stuckPolicy = policy
+}
+
+// IOTimeout is how long INPUT and OUTPUT wait on the channels, zero if they wait forever.
+func (ic *Computer) IOTimeout() time.Duration {
+	return ic.ioTimeout
+}
+
+func (ic *Computer) stuck(operation Opcode, direction string) error {
+	err := &StuckError{
+		Name:               ic.Name,
+		InstructionPointer: ic.instructionPointer,
+		Instruction:        operation.Name,
+		Direction:          direction,
+		Timeout:            ic.ioTimeout,
+	}
+
+	ic.log.Warn().Err(err).Str("policy", ic.stuckPolicy.String()).Msg("[COMPUTER] Stuck")
+
+	return err
+}
+
+// Read the next input, giving up after the timeout if there is one.
+func (ic *Computer) receive(operation Opcode) (AddressValue, error) {
+	if ic.ioTimeout == 0 {
+		return <-ic.Input, nil
+	}
+
+	timer := time.NewTimer(ic.ioTimeout)
+	defer timer.Stop()
+
+	select {
+	case value := <-ic.Input:
+		return value, nil
+	case <-timer.C:
+		err := ic.stuck(operation, "receive")
+		if ic.stuckPolicy == Drop {
+			return 0, errInputDropped
+		}
+
+		return 0, err
+	}
+}
+
+// Send an output, giving up after the timeout if there is one.
+func (ic *Computer) send(operation Opcode, value AddressValue) error {
+	if ic.ioTimeout == 0 {
+		ic.Output <- value
+
+		return nil
+	}
+
+	timer := time.NewTimer(ic.ioTimeout)
+	defer timer.Stop()
+
+	select {
+	case ic.Output <- value:
+		return nil
+	case <-timer.C:
+		err := ic.stuck(operation, "send")
+		if ic.stuckPolicy == Drop {
+			return nil
+		}
+
+		return err
+	}
+}
@@ -0,0 +1,87 @@
+package intcode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStuckPolicyString(t *testing.T) {
+	assert.Equal(t, "fault", Fault.String())
+	assert.Equal(t, "drop", Drop.String())
+	assert.Equal(t, "policy(5)", StuckPolicy(5).String())
+}
+
+func TestStuckInputFault(t *testing.T) {
+	computer := NewComputer([]AddressValue{104, 1, 3, 0, 99})
+	computer.Name = "reader"
+	computer.SetIOTimeout(10*time.Millisecond, Fault)
+	computer.Output = make(chan AddressValue, 1)
+
+	_, err := computer.Step()
+	assert.Nil(t, err)
+
+	_, err = computer.Step()
+
+	stuck, ok := err.(*StuckError)
+	assert.True(t, ok)
+	assert.Equal(t, "receive", stuck.Direction)
+	assert.Equal(t, "computer reader stuck trying to receive at 2 (INPUT) for 10ms", err.Error())
+	assert.Equal(t, AddressLocation(2), computer.InstructionPointer())
+}
+
+func TestStuckOutputFault(t *testing.T) {
+	computer := NewComputer([]AddressValue{104, 1, 104, 2, 99})
+	computer.Name = "writer"
+	computer.SetIOTimeout(10*time.Millisecond, Fault)
+
+	// Only ever read the first output
+	go func() {
+		<-computer.Output
+	}()
+
+	assert.PanicsWithError(t, "computer writer stuck trying to send at 2 (OUTPUT) for 10ms", func() {
+		computer.Run()
+	})
+}
+
+func TestStuckOutputDrop(t *testing.T) {
+	computer := NewComputer([]AddressValue{104, 1, 104, 2, 99})
+	computer.SetIOTimeout(10*time.Millisecond, Drop)
+
+	first := make(chan AddressValue)
+
+	go func() {
+		first <- <-computer.Output
+	}()
+
+	computer.Run()
+
+	assert.Equal(t, "halted", computer.State)
+	assert.Equal(t, AddressValue(1), <-first)
+}
+
+func TestStuckInputDrop(t *testing.T) {
+	computer := NewComputer([]AddressValue{3, 0, 99})
+	computer.SetIOTimeout(10*time.Millisecond, Drop)
+
+	computer.Run()
+
+	assert.Equal(t, "halted", computer.State)
+	assert.Equal(t, AddressLocation(0), computer.InstructionPointer())
+}
+
+func TestNoTimeout(t *testing.T) {
+	computer := NewComputer([]AddressValue{3, 0, 99})
+	assert.Equal(t, time.Duration(0), computer.IOTimeout())
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		computer.Input <- 5
+	}()
+
+	computer.Run()
+
+	assert.Equal(t, AddressValue(5), computer.Memory.Get(0))
+}
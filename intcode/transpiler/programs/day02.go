@@ -0,0 +1,535 @@
+// Code generated by intcode-transpile. DO NOT EDIT.
+
+package programs
+
+import "github.com/giodamelio/aoc-2020-go/intcode"
+
+// Day02 runs the intcode program from ../../../solutions/day-02/input.txt natively.
+//
+// Code the program changes at runtime is run by the interpreter.
+func Day02(computer *intcode.Computer) error {
+	mem := computer.Memory
+	ip := computer.InstructionPointer()
+	computer.State = "running"
+
+	// Policies, self modification tracking and custom instruction sets are only supported by the interpreter
+	if computer.RequiresInterpreter() {
+		for {
+			opcode, err := computer.Step()
+			if err != nil {
+				return err
+			}
+
+			if opcode == intcode.HALT {
+				computer.Halt()
+
+				return nil
+			}
+		}
+	}
+
+	for {
+		switch ip {
+		case 0: // ADD 0 0 3
+			if computer.OverflowDetection() || mem.Get(0) != 1 || mem.Get(1) != 0 || mem.Get(2) != 0 || mem.Get(3) != 3 {
+				break
+			}
+
+			if err := mem.Set(3, mem.Get(0)+mem.Get(0)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 4
+
+			fallthrough
+		case 4: // ADD 1 2 3
+			if computer.OverflowDetection() || mem.Get(4) != 1 || mem.Get(5) != 1 || mem.Get(6) != 2 || mem.Get(7) != 3 {
+				break
+			}
+
+			if err := mem.Set(3, mem.Get(1)+mem.Get(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 8
+
+			fallthrough
+		case 8: // ADD 3 4 3
+			if computer.OverflowDetection() || mem.Get(8) != 1 || mem.Get(9) != 3 || mem.Get(10) != 4 || mem.Get(11) != 3 {
+				break
+			}
+
+			if err := mem.Set(3, mem.Get(3)+mem.Get(4)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 12
+
+			fallthrough
+		case 12: // ADD 5 0 3
+			if computer.OverflowDetection() || mem.Get(12) != 1 || mem.Get(13) != 5 || mem.Get(14) != 0 || mem.Get(15) != 3 {
+				break
+			}
+
+			if err := mem.Set(3, mem.Get(5)+mem.Get(0)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 16
+
+			fallthrough
+		case 16: // MULTIPLY 13 1 19
+			if computer.OverflowDetection() || mem.Get(16) != 2 || mem.Get(17) != 13 || mem.Get(18) != 1 || mem.Get(19) != 19 {
+				break
+			}
+
+			if err := mem.Set(19, mem.Get(13)*mem.Get(1)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 20
+
+			fallthrough
+		case 20: // ADD 19 6 23
+			if computer.OverflowDetection() || mem.Get(20) != 1 || mem.Get(21) != 19 || mem.Get(22) != 6 || mem.Get(23) != 23 {
+				break
+			}
+
+			if err := mem.Set(23, mem.Get(19)+mem.Get(6)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 24
+
+			fallthrough
+		case 24: // ADD 23 6 27
+			if computer.OverflowDetection() || mem.Get(24) != 1 || mem.Get(25) != 23 || mem.Get(26) != 6 || mem.Get(27) != 27 {
+				break
+			}
+
+			if err := mem.Set(27, mem.Get(23)+mem.Get(6)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 28
+
+			fallthrough
+		case 28: // ADD 13 27 31
+			if computer.OverflowDetection() || mem.Get(28) != 1 || mem.Get(29) != 13 || mem.Get(30) != 27 || mem.Get(31) != 31 {
+				break
+			}
+
+			if err := mem.Set(31, mem.Get(13)+mem.Get(27)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 32
+
+			fallthrough
+		case 32: // MULTIPLY 13 31 35
+			if computer.OverflowDetection() || mem.Get(32) != 2 || mem.Get(33) != 13 || mem.Get(34) != 31 || mem.Get(35) != 35 {
+				break
+			}
+
+			if err := mem.Set(35, mem.Get(13)*mem.Get(31)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 36
+
+			fallthrough
+		case 36: // ADD 5 35 39
+			if computer.OverflowDetection() || mem.Get(36) != 1 || mem.Get(37) != 5 || mem.Get(38) != 35 || mem.Get(39) != 39 {
+				break
+			}
+
+			if err := mem.Set(39, mem.Get(5)+mem.Get(35)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 40
+
+			fallthrough
+		case 40: // MULTIPLY 39 13 43
+			if computer.OverflowDetection() || mem.Get(40) != 2 || mem.Get(41) != 39 || mem.Get(42) != 13 || mem.Get(43) != 43 {
+				break
+			}
+
+			if err := mem.Set(43, mem.Get(39)*mem.Get(13)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 44
+
+			fallthrough
+		case 44: // ADD 10 43 47
+			if computer.OverflowDetection() || mem.Get(44) != 1 || mem.Get(45) != 10 || mem.Get(46) != 43 || mem.Get(47) != 47 {
+				break
+			}
+
+			if err := mem.Set(47, mem.Get(10)+mem.Get(43)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 48
+
+			fallthrough
+		case 48: // MULTIPLY 13 47 51
+			if computer.OverflowDetection() || mem.Get(48) != 2 || mem.Get(49) != 13 || mem.Get(50) != 47 || mem.Get(51) != 51 {
+				break
+			}
+
+			if err := mem.Set(51, mem.Get(13)*mem.Get(47)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 52
+
+			fallthrough
+		case 52: // ADD 6 51 55
+			if computer.OverflowDetection() || mem.Get(52) != 1 || mem.Get(53) != 6 || mem.Get(54) != 51 || mem.Get(55) != 55 {
+				break
+			}
+
+			if err := mem.Set(55, mem.Get(6)+mem.Get(51)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 56
+
+			fallthrough
+		case 56: // MULTIPLY 55 13 59
+			if computer.OverflowDetection() || mem.Get(56) != 2 || mem.Get(57) != 55 || mem.Get(58) != 13 || mem.Get(59) != 59 {
+				break
+			}
+
+			if err := mem.Set(59, mem.Get(55)*mem.Get(13)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 60
+
+			fallthrough
+		case 60: // ADD 59 10 63
+			if computer.OverflowDetection() || mem.Get(60) != 1 || mem.Get(61) != 59 || mem.Get(62) != 10 || mem.Get(63) != 63 {
+				break
+			}
+
+			if err := mem.Set(63, mem.Get(59)+mem.Get(10)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 64
+
+			fallthrough
+		case 64: // ADD 63 10 67
+			if computer.OverflowDetection() || mem.Get(64) != 1 || mem.Get(65) != 63 || mem.Get(66) != 10 || mem.Get(67) != 67 {
+				break
+			}
+
+			if err := mem.Set(67, mem.Get(63)+mem.Get(10)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 68
+
+			fallthrough
+		case 68: // MULTIPLY 10 67 71
+			if computer.OverflowDetection() || mem.Get(68) != 2 || mem.Get(69) != 10 || mem.Get(70) != 67 || mem.Get(71) != 71 {
+				break
+			}
+
+			if err := mem.Set(71, mem.Get(10)*mem.Get(67)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 72
+
+			fallthrough
+		case 72: // ADD 6 71 75
+			if computer.OverflowDetection() || mem.Get(72) != 1 || mem.Get(73) != 6 || mem.Get(74) != 71 || mem.Get(75) != 75 {
+				break
+			}
+
+			if err := mem.Set(75, mem.Get(6)+mem.Get(71)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 76
+
+			fallthrough
+		case 76: // ADD 10 75 79
+			if computer.OverflowDetection() || mem.Get(76) != 1 || mem.Get(77) != 10 || mem.Get(78) != 75 || mem.Get(79) != 79 {
+				break
+			}
+
+			if err := mem.Set(79, mem.Get(10)+mem.Get(75)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 80
+
+			fallthrough
+		case 80: // ADD 79 9 83
+			if computer.OverflowDetection() || mem.Get(80) != 1 || mem.Get(81) != 79 || mem.Get(82) != 9 || mem.Get(83) != 83 {
+				break
+			}
+
+			if err := mem.Set(83, mem.Get(79)+mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 84
+
+			fallthrough
+		case 84: // MULTIPLY 83 6 87
+			if computer.OverflowDetection() || mem.Get(84) != 2 || mem.Get(85) != 83 || mem.Get(86) != 6 || mem.Get(87) != 87 {
+				break
+			}
+
+			if err := mem.Set(87, mem.Get(83)*mem.Get(6)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 88
+
+			fallthrough
+		case 88: // MULTIPLY 87 9 91
+			if computer.OverflowDetection() || mem.Get(88) != 2 || mem.Get(89) != 87 || mem.Get(90) != 9 || mem.Get(91) != 91 {
+				break
+			}
+
+			if err := mem.Set(91, mem.Get(87)*mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 92
+
+			fallthrough
+		case 92: // ADD 5 91 95
+			if computer.OverflowDetection() || mem.Get(92) != 1 || mem.Get(93) != 5 || mem.Get(94) != 91 || mem.Get(95) != 95 {
+				break
+			}
+
+			if err := mem.Set(95, mem.Get(5)+mem.Get(91)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 96
+
+			fallthrough
+		case 96: // ADD 6 95 99
+			if computer.OverflowDetection() || mem.Get(96) != 1 || mem.Get(97) != 6 || mem.Get(98) != 95 || mem.Get(99) != 99 {
+				break
+			}
+
+			if err := mem.Set(99, mem.Get(6)+mem.Get(95)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 100
+
+			fallthrough
+		case 100: // ADD 99 9 103
+			if computer.OverflowDetection() || mem.Get(100) != 1 || mem.Get(101) != 99 || mem.Get(102) != 9 || mem.Get(103) != 103 {
+				break
+			}
+
+			if err := mem.Set(103, mem.Get(99)+mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 104
+
+			fallthrough
+		case 104: // MULTIPLY 10 103 107
+			if computer.OverflowDetection() || mem.Get(104) != 2 || mem.Get(105) != 10 || mem.Get(106) != 103 || mem.Get(107) != 107 {
+				break
+			}
+
+			if err := mem.Set(107, mem.Get(10)*mem.Get(103)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 108
+
+			fallthrough
+		case 108: // ADD 107 6 111
+			if computer.OverflowDetection() || mem.Get(108) != 1 || mem.Get(109) != 107 || mem.Get(110) != 6 || mem.Get(111) != 111 {
+				break
+			}
+
+			if err := mem.Set(111, mem.Get(107)+mem.Get(6)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 112
+
+			fallthrough
+		case 112: // MULTIPLY 9 111 115
+			if computer.OverflowDetection() || mem.Get(112) != 2 || mem.Get(113) != 9 || mem.Get(114) != 111 || mem.Get(115) != 115 {
+				break
+			}
+
+			if err := mem.Set(115, mem.Get(9)*mem.Get(111)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 116
+
+			fallthrough
+		case 116: // ADD 5 115 119
+			if computer.OverflowDetection() || mem.Get(116) != 1 || mem.Get(117) != 5 || mem.Get(118) != 115 || mem.Get(119) != 119 {
+				break
+			}
+
+			if err := mem.Set(119, mem.Get(5)+mem.Get(115)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 120
+
+			fallthrough
+		case 120: // ADD 10 119 123
+			if computer.OverflowDetection() || mem.Get(120) != 1 || mem.Get(121) != 10 || mem.Get(122) != 119 || mem.Get(123) != 123 {
+				break
+			}
+
+			if err := mem.Set(123, mem.Get(10)+mem.Get(119)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 124
+
+			fallthrough
+		case 124: // ADD 2 123 127
+			if computer.OverflowDetection() || mem.Get(124) != 1 || mem.Get(125) != 2 || mem.Get(126) != 123 || mem.Get(127) != 127 {
+				break
+			}
+
+			if err := mem.Set(127, mem.Get(2)+mem.Get(123)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 128
+
+			fallthrough
+		case 128: // ADD 127 6 0
+			if computer.OverflowDetection() || mem.Get(128) != 1 || mem.Get(129) != 127 || mem.Get(130) != 6 || mem.Get(131) != 0 {
+				break
+			}
+
+			if err := mem.Set(0, mem.Get(127)+mem.Get(6)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 132
+
+			fallthrough
+		case 132: // HALT
+			if mem.Get(132) != 99 {
+				break
+			}
+
+			computer.Halt()
+
+			return nil
+		case 133: // MULTIPLY 14 0 0
+			if computer.OverflowDetection() || mem.Get(133) != 2 || mem.Get(134) != 14 || mem.Get(135) != 0 || mem.Get(136) != 0 {
+				break
+			}
+
+			if err := mem.Set(0, mem.Get(14)*mem.Get(0)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 137
+
+			continue
+		}
+
+		// Fall back to the interpreter for a single instruction
+		computer.SetInstructionPointer(ip)
+
+		opcode, err := computer.Step()
+		if err != nil {
+			return err
+		}
+
+		if opcode == intcode.HALT {
+			computer.Halt()
+
+			return nil
+		}
+
+		ip = computer.InstructionPointer()
+	}
+}
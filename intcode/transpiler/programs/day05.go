@@ -0,0 +1,2657 @@
+// Code generated by intcode-transpile. DO NOT EDIT.
+
+package programs
+
+import "github.com/giodamelio/aoc-2020-go/intcode"
+
+// Day05 runs the intcode program from ../../../solutions/day-05/input.txt natively.
+//
+// Code the program changes at runtime is run by the interpreter.
+func Day05(computer *intcode.Computer) error {
+	mem := computer.Memory
+	ip := computer.InstructionPointer()
+	computer.State = "running"
+
+	// Policies, self modification tracking and custom instruction sets are only supported by the interpreter
+	if computer.RequiresInterpreter() {
+		for {
+			opcode, err := computer.Step()
+			if err != nil {
+				return err
+			}
+
+			if opcode == intcode.HALT {
+				computer.Halt()
+
+				return nil
+			}
+		}
+	}
+
+	for {
+		switch ip {
+		case 0: // INPUT 225
+			if computer.IOTimeout() != 0 || mem.Get(0) != 3 || mem.Get(1) != 225 {
+				break
+			}
+
+			if err := mem.Set(225, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 2
+
+			fallthrough
+		case 2: // ADD 225 6 6
+			if computer.OverflowDetection() || mem.Get(2) != 1 || mem.Get(3) != 225 || mem.Get(4) != 6 || mem.Get(5) != 6 {
+				break
+			}
+
+			if err := mem.Set(6, mem.Get(225)+mem.Get(6)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 6
+
+			continue
+		case 7: // ADD 238 225 104
+			if computer.OverflowDetection() || mem.Get(7) != 1 || mem.Get(8) != 238 || mem.Get(9) != 225 || mem.Get(10) != 104 {
+				break
+			}
+
+			if err := mem.Set(104, mem.Get(238)+mem.Get(225)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 11
+
+			continue
+		case 12: // ADD i71 150 224
+			if computer.OverflowDetection() || mem.Get(12) != 101 || mem.Get(13) != 71 || mem.Get(14) != 150 || mem.Get(15) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, intcode.AddressValue(71)+mem.Get(150)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 16
+
+			fallthrough
+		case 16: // ADD i-123 224 224
+			if computer.OverflowDetection() || mem.Get(16) != 101 || mem.Get(17) != -123 || mem.Get(18) != 224 || mem.Get(19) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, intcode.AddressValue(-123)+mem.Get(224)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 20
+
+			fallthrough
+		case 20: // OUTPUT 224
+			if computer.IOTimeout() != 0 || mem.Get(20) != 4 || mem.Get(21) != 224 {
+				break
+			}
+
+			computer.Output <- mem.Get(224)
+
+			ip = 22
+
+			fallthrough
+		case 22: // MULTIPLY i8 223 223
+			if computer.OverflowDetection() || mem.Get(22) != 102 || mem.Get(23) != 8 || mem.Get(24) != 223 || mem.Get(25) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(8)*mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 26
+
+			fallthrough
+		case 26: // ADD i2 224 224
+			if computer.OverflowDetection() || mem.Get(26) != 101 || mem.Get(27) != 2 || mem.Get(28) != 224 || mem.Get(29) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, intcode.AddressValue(2)+mem.Get(224)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 30
+
+			fallthrough
+		case 30: // ADD 224 223 223
+			if computer.OverflowDetection() || mem.Get(30) != 1 || mem.Get(31) != 224 || mem.Get(32) != 223 || mem.Get(33) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(224)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 34
+
+			fallthrough
+		case 34: // MULTIPLY 205 209 224
+			if computer.OverflowDetection() || mem.Get(34) != 2 || mem.Get(35) != 205 || mem.Get(36) != 209 || mem.Get(37) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, mem.Get(205)*mem.Get(209)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 38
+
+			fallthrough
+		case 38: // ADD 224 i-3403 224
+			if computer.OverflowDetection() || mem.Get(38) != 1001 || mem.Get(39) != 224 || mem.Get(40) != -3403 || mem.Get(41) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, mem.Get(224)+intcode.AddressValue(-3403)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 42
+
+			fallthrough
+		case 42: // OUTPUT 224
+			if computer.IOTimeout() != 0 || mem.Get(42) != 4 || mem.Get(43) != 224 {
+				break
+			}
+
+			computer.Output <- mem.Get(224)
+
+			ip = 44
+
+			fallthrough
+		case 44: // MULTIPLY 223 i8 223
+			if computer.OverflowDetection() || mem.Get(44) != 1002 || mem.Get(45) != 223 || mem.Get(46) != 8 || mem.Get(47) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)*intcode.AddressValue(8)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 48
+
+			fallthrough
+		case 48: // ADD i1 224 224
+			if computer.OverflowDetection() || mem.Get(48) != 101 || mem.Get(49) != 1 || mem.Get(50) != 224 || mem.Get(51) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, intcode.AddressValue(1)+mem.Get(224)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 52
+
+			fallthrough
+		case 52: // ADD 223 224 223
+			if computer.OverflowDetection() || mem.Get(52) != 1 || mem.Get(53) != 223 || mem.Get(54) != 224 || mem.Get(55) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)+mem.Get(224)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 56
+
+			fallthrough
+		case 56: // ADD i55 i24 224
+			if computer.OverflowDetection() || mem.Get(56) != 1101 || mem.Get(57) != 55 || mem.Get(58) != 24 || mem.Get(59) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, intcode.AddressValue(55)+intcode.AddressValue(24)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 60
+
+			fallthrough
+		case 60: // ADD 224 i-79 224
+			if computer.OverflowDetection() || mem.Get(60) != 1001 || mem.Get(61) != 224 || mem.Get(62) != -79 || mem.Get(63) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, mem.Get(224)+intcode.AddressValue(-79)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 64
+
+			fallthrough
+		case 64: // OUTPUT 224
+			if computer.IOTimeout() != 0 || mem.Get(64) != 4 || mem.Get(65) != 224 {
+				break
+			}
+
+			computer.Output <- mem.Get(224)
+
+			ip = 66
+
+			fallthrough
+		case 66: // MULTIPLY 223 i8 223
+			if computer.OverflowDetection() || mem.Get(66) != 1002 || mem.Get(67) != 223 || mem.Get(68) != 8 || mem.Get(69) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)*intcode.AddressValue(8)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 70
+
+			fallthrough
+		case 70: // ADD i1 224 224
+			if computer.OverflowDetection() || mem.Get(70) != 101 || mem.Get(71) != 1 || mem.Get(72) != 224 || mem.Get(73) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, intcode.AddressValue(1)+mem.Get(224)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 74
+
+			fallthrough
+		case 74: // ADD 223 224 223
+			if computer.OverflowDetection() || mem.Get(74) != 1 || mem.Get(75) != 223 || mem.Get(76) != 224 || mem.Get(77) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)+mem.Get(224)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 78
+
+			fallthrough
+		case 78: // ADD 153 218 224
+			if computer.OverflowDetection() || mem.Get(78) != 1 || mem.Get(79) != 153 || mem.Get(80) != 218 || mem.Get(81) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, mem.Get(153)+mem.Get(218)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 82
+
+			fallthrough
+		case 82: // ADD 224 i-109 224
+			if computer.OverflowDetection() || mem.Get(82) != 1001 || mem.Get(83) != 224 || mem.Get(84) != -109 || mem.Get(85) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, mem.Get(224)+intcode.AddressValue(-109)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 86
+
+			fallthrough
+		case 86: // OUTPUT 224
+			if computer.IOTimeout() != 0 || mem.Get(86) != 4 || mem.Get(87) != 224 {
+				break
+			}
+
+			computer.Output <- mem.Get(224)
+
+			ip = 88
+
+			fallthrough
+		case 88: // MULTIPLY 223 i8 223
+			if computer.OverflowDetection() || mem.Get(88) != 1002 || mem.Get(89) != 223 || mem.Get(90) != 8 || mem.Get(91) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)*intcode.AddressValue(8)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 92
+
+			fallthrough
+		case 92: // ADD i5 224 224
+			if computer.OverflowDetection() || mem.Get(92) != 101 || mem.Get(93) != 5 || mem.Get(94) != 224 || mem.Get(95) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, intcode.AddressValue(5)+mem.Get(224)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 96
+
+			fallthrough
+		case 96: // ADD 224 223 223
+			if computer.OverflowDetection() || mem.Get(96) != 1 || mem.Get(97) != 224 || mem.Get(98) != 223 || mem.Get(99) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(224)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 100
+
+			fallthrough
+		case 100: // MULTIPLY 201 i72 224
+			if computer.OverflowDetection() || mem.Get(100) != 1002 || mem.Get(101) != 201 || mem.Get(102) != 72 || mem.Get(103) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, mem.Get(201)*intcode.AddressValue(72)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 104
+
+			fallthrough
+		case 104: // ADD 224 i-2088 224
+			if computer.OverflowDetection() || mem.Get(104) != 1001 || mem.Get(105) != 224 || mem.Get(106) != -2088 || mem.Get(107) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, mem.Get(224)+intcode.AddressValue(-2088)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 108
+
+			fallthrough
+		case 108: // OUTPUT 224
+			if computer.IOTimeout() != 0 || mem.Get(108) != 4 || mem.Get(109) != 224 {
+				break
+			}
+
+			computer.Output <- mem.Get(224)
+
+			ip = 110
+
+			fallthrough
+		case 110: // MULTIPLY i8 223 223
+			if computer.OverflowDetection() || mem.Get(110) != 102 || mem.Get(111) != 8 || mem.Get(112) != 223 || mem.Get(113) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(8)*mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 114
+
+			fallthrough
+		case 114: // ADD i3 224 224
+			if computer.OverflowDetection() || mem.Get(114) != 101 || mem.Get(115) != 3 || mem.Get(116) != 224 || mem.Get(117) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, intcode.AddressValue(3)+mem.Get(224)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 118
+
+			fallthrough
+		case 118: // ADD 223 224 223
+			if computer.OverflowDetection() || mem.Get(118) != 1 || mem.Get(119) != 223 || mem.Get(120) != 224 || mem.Get(121) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)+mem.Get(224)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 122
+
+			fallthrough
+		case 122: // MULTIPLY i70 i29 225
+			if computer.OverflowDetection() || mem.Get(122) != 1102 || mem.Get(123) != 70 || mem.Get(124) != 29 || mem.Get(125) != 225 {
+				break
+			}
+
+			if err := mem.Set(225, intcode.AddressValue(70)*intcode.AddressValue(29)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 126
+
+			fallthrough
+		case 126: // MULTIPLY i5 214 224
+			if computer.OverflowDetection() || mem.Get(126) != 102 || mem.Get(127) != 5 || mem.Get(128) != 214 || mem.Get(129) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, intcode.AddressValue(5)*mem.Get(214)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 130
+
+			fallthrough
+		case 130: // ADD i-250 224 224
+			if computer.OverflowDetection() || mem.Get(130) != 101 || mem.Get(131) != -250 || mem.Get(132) != 224 || mem.Get(133) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, intcode.AddressValue(-250)+mem.Get(224)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 134
+
+			fallthrough
+		case 134: // OUTPUT 224
+			if computer.IOTimeout() != 0 || mem.Get(134) != 4 || mem.Get(135) != 224 {
+				break
+			}
+
+			computer.Output <- mem.Get(224)
+
+			ip = 136
+
+			fallthrough
+		case 136: // MULTIPLY 223 i8 223
+			if computer.OverflowDetection() || mem.Get(136) != 1002 || mem.Get(137) != 223 || mem.Get(138) != 8 || mem.Get(139) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)*intcode.AddressValue(8)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 140
+
+			fallthrough
+		case 140: // ADD 224 i3 224
+			if computer.OverflowDetection() || mem.Get(140) != 1001 || mem.Get(141) != 224 || mem.Get(142) != 3 || mem.Get(143) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, mem.Get(224)+intcode.AddressValue(3)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 144
+
+			fallthrough
+		case 144: // ADD 223 224 223
+			if computer.OverflowDetection() || mem.Get(144) != 1 || mem.Get(145) != 223 || mem.Get(146) != 224 || mem.Get(147) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)+mem.Get(224)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 148
+
+			fallthrough
+		case 148: // ADD i12 i52 225
+			if computer.OverflowDetection() || mem.Get(148) != 1101 || mem.Get(149) != 12 || mem.Get(150) != 52 || mem.Get(151) != 225 {
+				break
+			}
+
+			if err := mem.Set(225, intcode.AddressValue(12)+intcode.AddressValue(52)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 152
+
+			fallthrough
+		case 152: // ADD i60 i71 225
+			if computer.OverflowDetection() || mem.Get(152) != 1101 || mem.Get(153) != 60 || mem.Get(154) != 71 || mem.Get(155) != 225 {
+				break
+			}
+
+			if err := mem.Set(225, intcode.AddressValue(60)+intcode.AddressValue(71)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 156
+
+			fallthrough
+		case 156: // ADD 123 i41 224
+			if computer.OverflowDetection() || mem.Get(156) != 1001 || mem.Get(157) != 123 || mem.Get(158) != 41 || mem.Get(159) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, mem.Get(123)+intcode.AddressValue(41)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 160
+
+			fallthrough
+		case 160: // ADD 224 i-111 224
+			if computer.OverflowDetection() || mem.Get(160) != 1001 || mem.Get(161) != 224 || mem.Get(162) != -111 || mem.Get(163) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, mem.Get(224)+intcode.AddressValue(-111)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 164
+
+			fallthrough
+		case 164: // OUTPUT 224
+			if computer.IOTimeout() != 0 || mem.Get(164) != 4 || mem.Get(165) != 224 {
+				break
+			}
+
+			computer.Output <- mem.Get(224)
+
+			ip = 166
+
+			fallthrough
+		case 166: // MULTIPLY i8 223 223
+			if computer.OverflowDetection() || mem.Get(166) != 102 || mem.Get(167) != 8 || mem.Get(168) != 223 || mem.Get(169) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(8)*mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 170
+
+			fallthrough
+		case 170: // ADD 224 i2 224
+			if computer.OverflowDetection() || mem.Get(170) != 1001 || mem.Get(171) != 224 || mem.Get(172) != 2 || mem.Get(173) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, mem.Get(224)+intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 174
+
+			fallthrough
+		case 174: // ADD 223 224 223
+			if computer.OverflowDetection() || mem.Get(174) != 1 || mem.Get(175) != 223 || mem.Get(176) != 224 || mem.Get(177) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)+mem.Get(224)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 178
+
+			fallthrough
+		case 178: // MULTIPLY i78 i66 224
+			if computer.OverflowDetection() || mem.Get(178) != 1102 || mem.Get(179) != 78 || mem.Get(180) != 66 || mem.Get(181) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, intcode.AddressValue(78)*intcode.AddressValue(66)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 182
+
+			fallthrough
+		case 182: // ADD 224 i-5148 224
+			if computer.OverflowDetection() || mem.Get(182) != 1001 || mem.Get(183) != 224 || mem.Get(184) != -5148 || mem.Get(185) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, mem.Get(224)+intcode.AddressValue(-5148)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 186
+
+			fallthrough
+		case 186: // OUTPUT 224
+			if computer.IOTimeout() != 0 || mem.Get(186) != 4 || mem.Get(187) != 224 {
+				break
+			}
+
+			computer.Output <- mem.Get(224)
+
+			ip = 188
+
+			fallthrough
+		case 188: // MULTIPLY 223 i8 223
+			if computer.OverflowDetection() || mem.Get(188) != 1002 || mem.Get(189) != 223 || mem.Get(190) != 8 || mem.Get(191) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)*intcode.AddressValue(8)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 192
+
+			fallthrough
+		case 192: // ADD 224 i2 224
+			if computer.OverflowDetection() || mem.Get(192) != 1001 || mem.Get(193) != 224 || mem.Get(194) != 2 || mem.Get(195) != 224 {
+				break
+			}
+
+			if err := mem.Set(224, mem.Get(224)+intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 196
+
+			fallthrough
+		case 196: // ADD 223 224 223
+			if computer.OverflowDetection() || mem.Get(196) != 1 || mem.Get(197) != 223 || mem.Get(198) != 224 || mem.Get(199) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)+mem.Get(224)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 200
+
+			fallthrough
+		case 200: // ADD i29 i77 225
+			if computer.OverflowDetection() || mem.Get(200) != 1101 || mem.Get(201) != 29 || mem.Get(202) != 77 || mem.Get(203) != 225 {
+				break
+			}
+
+			if err := mem.Set(225, intcode.AddressValue(29)+intcode.AddressValue(77)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 204
+
+			fallthrough
+		case 204: // MULTIPLY i41 i67 225
+			if computer.OverflowDetection() || mem.Get(204) != 1102 || mem.Get(205) != 41 || mem.Get(206) != 67 || mem.Get(207) != 225 {
+				break
+			}
+
+			if err := mem.Set(225, intcode.AddressValue(41)*intcode.AddressValue(67)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 208
+
+			fallthrough
+		case 208: // MULTIPLY i83 i32 225
+			if computer.OverflowDetection() || mem.Get(208) != 1102 || mem.Get(209) != 83 || mem.Get(210) != 32 || mem.Get(211) != 225 {
+				break
+			}
+
+			if err := mem.Set(225, intcode.AddressValue(83)*intcode.AddressValue(32)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 212
+
+			fallthrough
+		case 212: // ADD i93 i50 225
+			if computer.OverflowDetection() || mem.Get(212) != 1101 || mem.Get(213) != 93 || mem.Get(214) != 50 || mem.Get(215) != 225 {
+				break
+			}
+
+			if err := mem.Set(225, intcode.AddressValue(93)+intcode.AddressValue(50)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 216
+
+			fallthrough
+		case 216: // MULTIPLY i53 i49 225
+			if computer.OverflowDetection() || mem.Get(216) != 1102 || mem.Get(217) != 53 || mem.Get(218) != 49 || mem.Get(219) != 225 {
+				break
+			}
+
+			if err := mem.Set(225, intcode.AddressValue(53)*intcode.AddressValue(49)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 220
+
+			fallthrough
+		case 220: // OUTPUT 223
+			if computer.IOTimeout() != 0 || mem.Get(220) != 4 || mem.Get(221) != 223 {
+				break
+			}
+
+			computer.Output <- mem.Get(223)
+
+			ip = 222
+
+			fallthrough
+		case 222: // HALT
+			if mem.Get(222) != 99 {
+				break
+			}
+
+			computer.Halt()
+
+			return nil
+		case 238: // JUMP-IF-TRUE i0 i99999
+			if mem.Get(238) != 1105 || mem.Get(239) != 0 || mem.Get(240) != 99999 {
+				break
+			}
+
+			ip = 241
+
+			fallthrough
+		case 241: // JUMP-IF-TRUE i227 i247
+			if mem.Get(241) != 1105 || mem.Get(242) != 227 || mem.Get(243) != 247 {
+				break
+			}
+
+			ip = 247
+
+			continue
+		case 244: // JUMP-IF-TRUE i1 i99999
+			if mem.Get(244) != 1105 || mem.Get(245) != 1 || mem.Get(246) != 99999 {
+				break
+			}
+
+			ip = 99999
+
+			continue
+		case 247: // JUMP-IF-TRUE 227 i99999
+			if mem.Get(247) != 1005 || mem.Get(248) != 227 || mem.Get(249) != 99999 {
+				break
+			}
+
+			if mem.Get(227) != 0 {
+				ip = 99999
+
+				continue
+			}
+
+			ip = 250
+
+			fallthrough
+		case 250: // JUMP-IF-TRUE 0 i256
+			if mem.Get(250) != 1005 || mem.Get(251) != 0 || mem.Get(252) != 256 {
+				break
+			}
+
+			if mem.Get(0) != 0 {
+				ip = 256
+
+				continue
+			}
+
+			ip = 253
+
+			fallthrough
+		case 253: // JUMP-IF-TRUE i1 i99999
+			if mem.Get(253) != 1105 || mem.Get(254) != 1 || mem.Get(255) != 99999 {
+				break
+			}
+
+			ip = 99999
+
+			continue
+		case 256: // JUMP-IF-FALSE i227 i99999
+			if mem.Get(256) != 1106 || mem.Get(257) != 227 || mem.Get(258) != 99999 {
+				break
+			}
+
+			ip = 259
+
+			fallthrough
+		case 259: // JUMP-IF-FALSE i0 i265
+			if mem.Get(259) != 1106 || mem.Get(260) != 0 || mem.Get(261) != 265 {
+				break
+			}
+
+			ip = 265
+
+			continue
+		case 262: // JUMP-IF-TRUE i1 i99999
+			if mem.Get(262) != 1105 || mem.Get(263) != 1 || mem.Get(264) != 99999 {
+				break
+			}
+
+			ip = 99999
+
+			continue
+		case 265: // JUMP-IF-FALSE 0 i99999
+			if mem.Get(265) != 1006 || mem.Get(266) != 0 || mem.Get(267) != 99999 {
+				break
+			}
+
+			if mem.Get(0) == 0 {
+				ip = 99999
+
+				continue
+			}
+
+			ip = 268
+
+			fallthrough
+		case 268: // JUMP-IF-FALSE 227 i274
+			if mem.Get(268) != 1006 || mem.Get(269) != 227 || mem.Get(270) != 274 {
+				break
+			}
+
+			if mem.Get(227) == 0 {
+				ip = 274
+
+				continue
+			}
+
+			ip = 271
+
+			fallthrough
+		case 271: // JUMP-IF-TRUE i1 i99999
+			if mem.Get(271) != 1105 || mem.Get(272) != 1 || mem.Get(273) != 99999 {
+				break
+			}
+
+			ip = 99999
+
+			continue
+		case 274: // JUMP-IF-TRUE i1 i280
+			if mem.Get(274) != 1105 || mem.Get(275) != 1 || mem.Get(276) != 280 {
+				break
+			}
+
+			ip = 280
+
+			continue
+		case 277: // JUMP-IF-TRUE i1 i99999
+			if mem.Get(277) != 1105 || mem.Get(278) != 1 || mem.Get(279) != 99999 {
+				break
+			}
+
+			ip = 99999
+
+			continue
+		case 280: // ADD 225 225 225
+			if computer.OverflowDetection() || mem.Get(280) != 1 || mem.Get(281) != 225 || mem.Get(282) != 225 || mem.Get(283) != 225 {
+				break
+			}
+
+			if err := mem.Set(225, mem.Get(225)+mem.Get(225)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 284
+
+			fallthrough
+		case 284: // ADD i294 i0 0
+			if computer.OverflowDetection() || mem.Get(284) != 1101 || mem.Get(285) != 294 || mem.Get(286) != 0 || mem.Get(287) != 0 {
+				break
+			}
+
+			if err := mem.Set(0, intcode.AddressValue(294)+intcode.AddressValue(0)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 288
+
+			fallthrough
+		case 288: // JUMP-IF-TRUE i1 0
+			if mem.Get(288) != 105 || mem.Get(289) != 1 || mem.Get(290) != 0 {
+				break
+			}
+
+			ip = intcode.AddressLocation(mem.Get(0))
+
+			continue
+		case 291: // JUMP-IF-TRUE i1 i99999
+			if mem.Get(291) != 1105 || mem.Get(292) != 1 || mem.Get(293) != 99999 {
+				break
+			}
+
+			ip = 99999
+
+			continue
+		case 294: // JUMP-IF-FALSE i0 i300
+			if mem.Get(294) != 1106 || mem.Get(295) != 0 || mem.Get(296) != 300 {
+				break
+			}
+
+			ip = 300
+
+			continue
+		case 297: // JUMP-IF-TRUE i1 i99999
+			if mem.Get(297) != 1105 || mem.Get(298) != 1 || mem.Get(299) != 99999 {
+				break
+			}
+
+			ip = 99999
+
+			continue
+		case 300: // ADD 225 225 225
+			if computer.OverflowDetection() || mem.Get(300) != 1 || mem.Get(301) != 225 || mem.Get(302) != 225 || mem.Get(303) != 225 {
+				break
+			}
+
+			if err := mem.Set(225, mem.Get(225)+mem.Get(225)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 304
+
+			fallthrough
+		case 304: // ADD i314 i0 0
+			if computer.OverflowDetection() || mem.Get(304) != 1101 || mem.Get(305) != 314 || mem.Get(306) != 0 || mem.Get(307) != 0 {
+				break
+			}
+
+			if err := mem.Set(0, intcode.AddressValue(314)+intcode.AddressValue(0)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 308
+
+			fallthrough
+		case 308: // JUMP-IF-FALSE i0 0
+			if mem.Get(308) != 106 || mem.Get(309) != 0 || mem.Get(310) != 0 {
+				break
+			}
+
+			ip = intcode.AddressLocation(mem.Get(0))
+
+			continue
+		case 311: // JUMP-IF-TRUE i1 i99999
+			if mem.Get(311) != 1105 || mem.Get(312) != 1 || mem.Get(313) != 99999 {
+				break
+			}
+
+			ip = 99999
+
+			continue
+		case 314: // LESS-THAN i677 i677 224
+			if mem.Get(314) != 1107 || mem.Get(315) != 677 || mem.Get(316) != 677 || mem.Get(317) != 224 {
+				break
+			}
+
+			if intcode.AddressValue(677) < intcode.AddressValue(677) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 318
+
+			fallthrough
+		case 318: // MULTIPLY 223 i2 223
+			if computer.OverflowDetection() || mem.Get(318) != 1002 || mem.Get(319) != 223 || mem.Get(320) != 2 || mem.Get(321) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 322
+
+			fallthrough
+		case 322: // JUMP-IF-TRUE 224 i329
+			if mem.Get(322) != 1005 || mem.Get(323) != 224 || mem.Get(324) != 329 {
+				break
+			}
+
+			if mem.Get(224) != 0 {
+				ip = 329
+
+				continue
+			}
+
+			ip = 325
+
+			fallthrough
+		case 325: // ADD i1 223 223
+			if computer.OverflowDetection() || mem.Get(325) != 101 || mem.Get(326) != 1 || mem.Get(327) != 223 || mem.Get(328) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(1)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 329
+
+			fallthrough
+		case 329: // LESS-THAN 677 677 224
+			if mem.Get(329) != 7 || mem.Get(330) != 677 || mem.Get(331) != 677 || mem.Get(332) != 224 {
+				break
+			}
+
+			if mem.Get(677) < mem.Get(677) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 333
+
+			fallthrough
+		case 333: // MULTIPLY 223 i2 223
+			if computer.OverflowDetection() || mem.Get(333) != 1002 || mem.Get(334) != 223 || mem.Get(335) != 2 || mem.Get(336) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 337
+
+			fallthrough
+		case 337: // JUMP-IF-TRUE 224 i344
+			if mem.Get(337) != 1005 || mem.Get(338) != 224 || mem.Get(339) != 344 {
+				break
+			}
+
+			if mem.Get(224) != 0 {
+				ip = 344
+
+				continue
+			}
+
+			ip = 340
+
+			fallthrough
+		case 340: // ADD 223 i1 223
+			if computer.OverflowDetection() || mem.Get(340) != 1001 || mem.Get(341) != 223 || mem.Get(342) != 1 || mem.Get(343) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)+intcode.AddressValue(1)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 344
+
+			fallthrough
+		case 344: // LESS-THAN 226 677 224
+			if mem.Get(344) != 7 || mem.Get(345) != 226 || mem.Get(346) != 677 || mem.Get(347) != 224 {
+				break
+			}
+
+			if mem.Get(226) < mem.Get(677) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 348
+
+			fallthrough
+		case 348: // MULTIPLY i2 223 223
+			if computer.OverflowDetection() || mem.Get(348) != 102 || mem.Get(349) != 2 || mem.Get(350) != 223 || mem.Get(351) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(2)*mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 352
+
+			fallthrough
+		case 352: // JUMP-IF-FALSE 224 i359
+			if mem.Get(352) != 1006 || mem.Get(353) != 224 || mem.Get(354) != 359 {
+				break
+			}
+
+			if mem.Get(224) == 0 {
+				ip = 359
+
+				continue
+			}
+
+			ip = 355
+
+			fallthrough
+		case 355: // ADD i1 223 223
+			if computer.OverflowDetection() || mem.Get(355) != 101 || mem.Get(356) != 1 || mem.Get(357) != 223 || mem.Get(358) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(1)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 359
+
+			fallthrough
+		case 359: // EQUALS i226 i226 224
+			if mem.Get(359) != 1108 || mem.Get(360) != 226 || mem.Get(361) != 226 || mem.Get(362) != 224 {
+				break
+			}
+
+			if intcode.AddressValue(226) == intcode.AddressValue(226) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 363
+
+			fallthrough
+		case 363: // MULTIPLY 223 i2 223
+			if computer.OverflowDetection() || mem.Get(363) != 1002 || mem.Get(364) != 223 || mem.Get(365) != 2 || mem.Get(366) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 367
+
+			fallthrough
+		case 367: // JUMP-IF-TRUE 224 i374
+			if mem.Get(367) != 1005 || mem.Get(368) != 224 || mem.Get(369) != 374 {
+				break
+			}
+
+			if mem.Get(224) != 0 {
+				ip = 374
+
+				continue
+			}
+
+			ip = 370
+
+			fallthrough
+		case 370: // ADD 223 i1 223
+			if computer.OverflowDetection() || mem.Get(370) != 1001 || mem.Get(371) != 223 || mem.Get(372) != 1 || mem.Get(373) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)+intcode.AddressValue(1)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 374
+
+			fallthrough
+		case 374: // EQUALS 226 677 224
+			if mem.Get(374) != 8 || mem.Get(375) != 226 || mem.Get(376) != 677 || mem.Get(377) != 224 {
+				break
+			}
+
+			if mem.Get(226) == mem.Get(677) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 378
+
+			fallthrough
+		case 378: // MULTIPLY 223 i2 223
+			if computer.OverflowDetection() || mem.Get(378) != 1002 || mem.Get(379) != 223 || mem.Get(380) != 2 || mem.Get(381) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 382
+
+			fallthrough
+		case 382: // JUMP-IF-FALSE 224 i389
+			if mem.Get(382) != 1006 || mem.Get(383) != 224 || mem.Get(384) != 389 {
+				break
+			}
+
+			if mem.Get(224) == 0 {
+				ip = 389
+
+				continue
+			}
+
+			ip = 385
+
+			fallthrough
+		case 385: // ADD 223 i1 223
+			if computer.OverflowDetection() || mem.Get(385) != 1001 || mem.Get(386) != 223 || mem.Get(387) != 1 || mem.Get(388) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)+intcode.AddressValue(1)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 389
+
+			fallthrough
+		case 389: // EQUALS i226 i677 224
+			if mem.Get(389) != 1108 || mem.Get(390) != 226 || mem.Get(391) != 677 || mem.Get(392) != 224 {
+				break
+			}
+
+			if intcode.AddressValue(226) == intcode.AddressValue(677) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 393
+
+			fallthrough
+		case 393: // MULTIPLY 223 i2 223
+			if computer.OverflowDetection() || mem.Get(393) != 1002 || mem.Get(394) != 223 || mem.Get(395) != 2 || mem.Get(396) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 397
+
+			fallthrough
+		case 397: // JUMP-IF-FALSE 224 i404
+			if mem.Get(397) != 1006 || mem.Get(398) != 224 || mem.Get(399) != 404 {
+				break
+			}
+
+			if mem.Get(224) == 0 {
+				ip = 404
+
+				continue
+			}
+
+			ip = 400
+
+			fallthrough
+		case 400: // ADD i1 223 223
+			if computer.OverflowDetection() || mem.Get(400) != 101 || mem.Get(401) != 1 || mem.Get(402) != 223 || mem.Get(403) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(1)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 404
+
+			fallthrough
+		case 404: // LESS-THAN i677 i226 224
+			if mem.Get(404) != 1107 || mem.Get(405) != 677 || mem.Get(406) != 226 || mem.Get(407) != 224 {
+				break
+			}
+
+			if intcode.AddressValue(677) < intcode.AddressValue(226) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 408
+
+			fallthrough
+		case 408: // MULTIPLY i2 223 223
+			if computer.OverflowDetection() || mem.Get(408) != 102 || mem.Get(409) != 2 || mem.Get(410) != 223 || mem.Get(411) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(2)*mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 412
+
+			fallthrough
+		case 412: // JUMP-IF-FALSE 224 i419
+			if mem.Get(412) != 1006 || mem.Get(413) != 224 || mem.Get(414) != 419 {
+				break
+			}
+
+			if mem.Get(224) == 0 {
+				ip = 419
+
+				continue
+			}
+
+			ip = 415
+
+			fallthrough
+		case 415: // ADD i1 223 223
+			if computer.OverflowDetection() || mem.Get(415) != 101 || mem.Get(416) != 1 || mem.Get(417) != 223 || mem.Get(418) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(1)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 419
+
+			fallthrough
+		case 419: // LESS-THAN 677 i677 224
+			if mem.Get(419) != 1007 || mem.Get(420) != 677 || mem.Get(421) != 677 || mem.Get(422) != 224 {
+				break
+			}
+
+			if mem.Get(677) < intcode.AddressValue(677) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 423
+
+			fallthrough
+		case 423: // MULTIPLY 223 i2 223
+			if computer.OverflowDetection() || mem.Get(423) != 1002 || mem.Get(424) != 223 || mem.Get(425) != 2 || mem.Get(426) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 427
+
+			fallthrough
+		case 427: // JUMP-IF-TRUE 224 i434
+			if mem.Get(427) != 1005 || mem.Get(428) != 224 || mem.Get(429) != 434 {
+				break
+			}
+
+			if mem.Get(224) != 0 {
+				ip = 434
+
+				continue
+			}
+
+			ip = 430
+
+			fallthrough
+		case 430: // ADD i1 223 223
+			if computer.OverflowDetection() || mem.Get(430) != 101 || mem.Get(431) != 1 || mem.Get(432) != 223 || mem.Get(433) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(1)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 434
+
+			fallthrough
+		case 434: // LESS-THAN 677 226 224
+			if mem.Get(434) != 7 || mem.Get(435) != 677 || mem.Get(436) != 226 || mem.Get(437) != 224 {
+				break
+			}
+
+			if mem.Get(677) < mem.Get(226) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 438
+
+			fallthrough
+		case 438: // MULTIPLY i2 223 223
+			if computer.OverflowDetection() || mem.Get(438) != 102 || mem.Get(439) != 2 || mem.Get(440) != 223 || mem.Get(441) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(2)*mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 442
+
+			fallthrough
+		case 442: // JUMP-IF-FALSE 224 i449
+			if mem.Get(442) != 1006 || mem.Get(443) != 224 || mem.Get(444) != 449 {
+				break
+			}
+
+			if mem.Get(224) == 0 {
+				ip = 449
+
+				continue
+			}
+
+			ip = 445
+
+			fallthrough
+		case 445: // ADD 223 i1 223
+			if computer.OverflowDetection() || mem.Get(445) != 1001 || mem.Get(446) != 223 || mem.Get(447) != 1 || mem.Get(448) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)+intcode.AddressValue(1)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 449
+
+			fallthrough
+		case 449: // EQUALS 226 i677 224
+			if mem.Get(449) != 1008 || mem.Get(450) != 226 || mem.Get(451) != 677 || mem.Get(452) != 224 {
+				break
+			}
+
+			if mem.Get(226) == intcode.AddressValue(677) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 453
+
+			fallthrough
+		case 453: // MULTIPLY 223 i2 223
+			if computer.OverflowDetection() || mem.Get(453) != 1002 || mem.Get(454) != 223 || mem.Get(455) != 2 || mem.Get(456) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 457
+
+			fallthrough
+		case 457: // JUMP-IF-FALSE 224 i464
+			if mem.Get(457) != 1006 || mem.Get(458) != 224 || mem.Get(459) != 464 {
+				break
+			}
+
+			if mem.Get(224) == 0 {
+				ip = 464
+
+				continue
+			}
+
+			ip = 460
+
+			fallthrough
+		case 460: // ADD i1 223 223
+			if computer.OverflowDetection() || mem.Get(460) != 101 || mem.Get(461) != 1 || mem.Get(462) != 223 || mem.Get(463) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(1)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 464
+
+			fallthrough
+		case 464: // EQUALS 677 677 224
+			if mem.Get(464) != 8 || mem.Get(465) != 677 || mem.Get(466) != 677 || mem.Get(467) != 224 {
+				break
+			}
+
+			if mem.Get(677) == mem.Get(677) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 468
+
+			fallthrough
+		case 468: // MULTIPLY 223 i2 223
+			if computer.OverflowDetection() || mem.Get(468) != 1002 || mem.Get(469) != 223 || mem.Get(470) != 2 || mem.Get(471) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 472
+
+			fallthrough
+		case 472: // JUMP-IF-FALSE 224 i479
+			if mem.Get(472) != 1006 || mem.Get(473) != 224 || mem.Get(474) != 479 {
+				break
+			}
+
+			if mem.Get(224) == 0 {
+				ip = 479
+
+				continue
+			}
+
+			ip = 475
+
+			fallthrough
+		case 475: // ADD i1 223 223
+			if computer.OverflowDetection() || mem.Get(475) != 101 || mem.Get(476) != 1 || mem.Get(477) != 223 || mem.Get(478) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(1)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 479
+
+			fallthrough
+		case 479: // EQUALS i226 226 224
+			if mem.Get(479) != 108 || mem.Get(480) != 226 || mem.Get(481) != 226 || mem.Get(482) != 224 {
+				break
+			}
+
+			if intcode.AddressValue(226) == mem.Get(226) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 483
+
+			fallthrough
+		case 483: // MULTIPLY i2 223 223
+			if computer.OverflowDetection() || mem.Get(483) != 102 || mem.Get(484) != 2 || mem.Get(485) != 223 || mem.Get(486) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(2)*mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 487
+
+			fallthrough
+		case 487: // JUMP-IF-TRUE 224 i494
+			if mem.Get(487) != 1005 || mem.Get(488) != 224 || mem.Get(489) != 494 {
+				break
+			}
+
+			if mem.Get(224) != 0 {
+				ip = 494
+
+				continue
+			}
+
+			ip = 490
+
+			fallthrough
+		case 490: // ADD i1 223 223
+			if computer.OverflowDetection() || mem.Get(490) != 101 || mem.Get(491) != 1 || mem.Get(492) != 223 || mem.Get(493) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(1)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 494
+
+			fallthrough
+		case 494: // LESS-THAN i226 i677 224
+			if mem.Get(494) != 1107 || mem.Get(495) != 226 || mem.Get(496) != 677 || mem.Get(497) != 224 {
+				break
+			}
+
+			if intcode.AddressValue(226) < intcode.AddressValue(677) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 498
+
+			fallthrough
+		case 498: // MULTIPLY 223 i2 223
+			if computer.OverflowDetection() || mem.Get(498) != 1002 || mem.Get(499) != 223 || mem.Get(500) != 2 || mem.Get(501) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 502
+
+			fallthrough
+		case 502: // JUMP-IF-FALSE 224 i509
+			if mem.Get(502) != 1006 || mem.Get(503) != 224 || mem.Get(504) != 509 {
+				break
+			}
+
+			if mem.Get(224) == 0 {
+				ip = 509
+
+				continue
+			}
+
+			ip = 505
+
+			fallthrough
+		case 505: // ADD i1 223 223
+			if computer.OverflowDetection() || mem.Get(505) != 101 || mem.Get(506) != 1 || mem.Get(507) != 223 || mem.Get(508) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(1)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 509
+
+			fallthrough
+		case 509: // LESS-THAN i226 226 224
+			if mem.Get(509) != 107 || mem.Get(510) != 226 || mem.Get(511) != 226 || mem.Get(512) != 224 {
+				break
+			}
+
+			if intcode.AddressValue(226) < mem.Get(226) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 513
+
+			fallthrough
+		case 513: // MULTIPLY 223 i2 223
+			if computer.OverflowDetection() || mem.Get(513) != 1002 || mem.Get(514) != 223 || mem.Get(515) != 2 || mem.Get(516) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 517
+
+			fallthrough
+		case 517: // JUMP-IF-FALSE 224 i524
+			if mem.Get(517) != 1006 || mem.Get(518) != 224 || mem.Get(519) != 524 {
+				break
+			}
+
+			if mem.Get(224) == 0 {
+				ip = 524
+
+				continue
+			}
+
+			ip = 520
+
+			fallthrough
+		case 520: // ADD 223 i1 223
+			if computer.OverflowDetection() || mem.Get(520) != 1001 || mem.Get(521) != 223 || mem.Get(522) != 1 || mem.Get(523) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)+intcode.AddressValue(1)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 524
+
+			fallthrough
+		case 524: // LESS-THAN i677 677 224
+			if mem.Get(524) != 107 || mem.Get(525) != 677 || mem.Get(526) != 677 || mem.Get(527) != 224 {
+				break
+			}
+
+			if intcode.AddressValue(677) < mem.Get(677) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 528
+
+			fallthrough
+		case 528: // MULTIPLY 223 i2 223
+			if computer.OverflowDetection() || mem.Get(528) != 1002 || mem.Get(529) != 223 || mem.Get(530) != 2 || mem.Get(531) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 532
+
+			fallthrough
+		case 532: // JUMP-IF-TRUE 224 i539
+			if mem.Get(532) != 1005 || mem.Get(533) != 224 || mem.Get(534) != 539 {
+				break
+			}
+
+			if mem.Get(224) != 0 {
+				ip = 539
+
+				continue
+			}
+
+			ip = 535
+
+			fallthrough
+		case 535: // ADD i1 223 223
+			if computer.OverflowDetection() || mem.Get(535) != 101 || mem.Get(536) != 1 || mem.Get(537) != 223 || mem.Get(538) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(1)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 539
+
+			fallthrough
+		case 539: // LESS-THAN 226 i226 224
+			if mem.Get(539) != 1007 || mem.Get(540) != 226 || mem.Get(541) != 226 || mem.Get(542) != 224 {
+				break
+			}
+
+			if mem.Get(226) < intcode.AddressValue(226) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 543
+
+			fallthrough
+		case 543: // MULTIPLY i2 223 223
+			if computer.OverflowDetection() || mem.Get(543) != 102 || mem.Get(544) != 2 || mem.Get(545) != 223 || mem.Get(546) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(2)*mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 547
+
+			fallthrough
+		case 547: // JUMP-IF-FALSE 224 i554
+			if mem.Get(547) != 1006 || mem.Get(548) != 224 || mem.Get(549) != 554 {
+				break
+			}
+
+			if mem.Get(224) == 0 {
+				ip = 554
+
+				continue
+			}
+
+			ip = 550
+
+			fallthrough
+		case 550: // ADD i1 223 223
+			if computer.OverflowDetection() || mem.Get(550) != 101 || mem.Get(551) != 1 || mem.Get(552) != 223 || mem.Get(553) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(1)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 554
+
+			fallthrough
+		case 554: // EQUALS i677 677 224
+			if mem.Get(554) != 108 || mem.Get(555) != 677 || mem.Get(556) != 677 || mem.Get(557) != 224 {
+				break
+			}
+
+			if intcode.AddressValue(677) == mem.Get(677) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 558
+
+			fallthrough
+		case 558: // MULTIPLY i2 223 223
+			if computer.OverflowDetection() || mem.Get(558) != 102 || mem.Get(559) != 2 || mem.Get(560) != 223 || mem.Get(561) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(2)*mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 562
+
+			fallthrough
+		case 562: // JUMP-IF-TRUE 224 i569
+			if mem.Get(562) != 1005 || mem.Get(563) != 224 || mem.Get(564) != 569 {
+				break
+			}
+
+			if mem.Get(224) != 0 {
+				ip = 569
+
+				continue
+			}
+
+			ip = 565
+
+			fallthrough
+		case 565: // ADD i1 223 223
+			if computer.OverflowDetection() || mem.Get(565) != 101 || mem.Get(566) != 1 || mem.Get(567) != 223 || mem.Get(568) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(1)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 569
+
+			fallthrough
+		case 569: // LESS-THAN i677 226 224
+			if mem.Get(569) != 107 || mem.Get(570) != 677 || mem.Get(571) != 226 || mem.Get(572) != 224 {
+				break
+			}
+
+			if intcode.AddressValue(677) < mem.Get(226) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 573
+
+			fallthrough
+		case 573: // MULTIPLY i2 223 223
+			if computer.OverflowDetection() || mem.Get(573) != 102 || mem.Get(574) != 2 || mem.Get(575) != 223 || mem.Get(576) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(2)*mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 577
+
+			fallthrough
+		case 577: // JUMP-IF-TRUE 224 i584
+			if mem.Get(577) != 1005 || mem.Get(578) != 224 || mem.Get(579) != 584 {
+				break
+			}
+
+			if mem.Get(224) != 0 {
+				ip = 584
+
+				continue
+			}
+
+			ip = 580
+
+			fallthrough
+		case 580: // ADD i1 223 223
+			if computer.OverflowDetection() || mem.Get(580) != 101 || mem.Get(581) != 1 || mem.Get(582) != 223 || mem.Get(583) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(1)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 584
+
+			fallthrough
+		case 584: // EQUALS 226 i226 224
+			if mem.Get(584) != 1008 || mem.Get(585) != 226 || mem.Get(586) != 226 || mem.Get(587) != 224 {
+				break
+			}
+
+			if mem.Get(226) == intcode.AddressValue(226) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 588
+
+			fallthrough
+		case 588: // MULTIPLY i2 223 223
+			if computer.OverflowDetection() || mem.Get(588) != 102 || mem.Get(589) != 2 || mem.Get(590) != 223 || mem.Get(591) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(2)*mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 592
+
+			fallthrough
+		case 592: // JUMP-IF-FALSE 224 i599
+			if mem.Get(592) != 1006 || mem.Get(593) != 224 || mem.Get(594) != 599 {
+				break
+			}
+
+			if mem.Get(224) == 0 {
+				ip = 599
+
+				continue
+			}
+
+			ip = 595
+
+			fallthrough
+		case 595: // ADD i1 223 223
+			if computer.OverflowDetection() || mem.Get(595) != 101 || mem.Get(596) != 1 || mem.Get(597) != 223 || mem.Get(598) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(1)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 599
+
+			fallthrough
+		case 599: // EQUALS i677 i226 224
+			if mem.Get(599) != 1108 || mem.Get(600) != 677 || mem.Get(601) != 226 || mem.Get(602) != 224 {
+				break
+			}
+
+			if intcode.AddressValue(677) == intcode.AddressValue(226) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 603
+
+			fallthrough
+		case 603: // MULTIPLY 223 i2 223
+			if computer.OverflowDetection() || mem.Get(603) != 1002 || mem.Get(604) != 223 || mem.Get(605) != 2 || mem.Get(606) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 607
+
+			fallthrough
+		case 607: // JUMP-IF-FALSE 224 i614
+			if mem.Get(607) != 1006 || mem.Get(608) != 224 || mem.Get(609) != 614 {
+				break
+			}
+
+			if mem.Get(224) == 0 {
+				ip = 614
+
+				continue
+			}
+
+			ip = 610
+
+			fallthrough
+		case 610: // ADD i1 223 223
+			if computer.OverflowDetection() || mem.Get(610) != 101 || mem.Get(611) != 1 || mem.Get(612) != 223 || mem.Get(613) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(1)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 614
+
+			fallthrough
+		case 614: // EQUALS 677 226 224
+			if mem.Get(614) != 8 || mem.Get(615) != 677 || mem.Get(616) != 226 || mem.Get(617) != 224 {
+				break
+			}
+
+			if mem.Get(677) == mem.Get(226) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 618
+
+			fallthrough
+		case 618: // MULTIPLY i2 223 223
+			if computer.OverflowDetection() || mem.Get(618) != 102 || mem.Get(619) != 2 || mem.Get(620) != 223 || mem.Get(621) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(2)*mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 622
+
+			fallthrough
+		case 622: // JUMP-IF-TRUE 224 i629
+			if mem.Get(622) != 1005 || mem.Get(623) != 224 || mem.Get(624) != 629 {
+				break
+			}
+
+			if mem.Get(224) != 0 {
+				ip = 629
+
+				continue
+			}
+
+			ip = 625
+
+			fallthrough
+		case 625: // ADD 223 i1 223
+			if computer.OverflowDetection() || mem.Get(625) != 1001 || mem.Get(626) != 223 || mem.Get(627) != 1 || mem.Get(628) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, mem.Get(223)+intcode.AddressValue(1)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 629
+
+			fallthrough
+		case 629: // EQUALS 677 i677 224
+			if mem.Get(629) != 1008 || mem.Get(630) != 677 || mem.Get(631) != 677 || mem.Get(632) != 224 {
+				break
+			}
+
+			if mem.Get(677) == intcode.AddressValue(677) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 633
+
+			fallthrough
+		case 633: // MULTIPLY i2 223 223
+			if computer.OverflowDetection() || mem.Get(633) != 102 || mem.Get(634) != 2 || mem.Get(635) != 223 || mem.Get(636) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(2)*mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 637
+
+			fallthrough
+		case 637: // JUMP-IF-FALSE 224 i644
+			if mem.Get(637) != 1006 || mem.Get(638) != 224 || mem.Get(639) != 644 {
+				break
+			}
+
+			if mem.Get(224) == 0 {
+				ip = 644
+
+				continue
+			}
+
+			ip = 640
+
+			fallthrough
+		case 640: // ADD i1 223 223
+			if computer.OverflowDetection() || mem.Get(640) != 101 || mem.Get(641) != 1 || mem.Get(642) != 223 || mem.Get(643) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(1)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 644
+
+			fallthrough
+		case 644: // LESS-THAN 226 i677 224
+			if mem.Get(644) != 1007 || mem.Get(645) != 226 || mem.Get(646) != 677 || mem.Get(647) != 224 {
+				break
+			}
+
+			if mem.Get(226) < intcode.AddressValue(677) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 648
+
+			fallthrough
+		case 648: // MULTIPLY i2 223 223
+			if computer.OverflowDetection() || mem.Get(648) != 102 || mem.Get(649) != 2 || mem.Get(650) != 223 || mem.Get(651) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(2)*mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 652
+
+			fallthrough
+		case 652: // JUMP-IF-TRUE 224 i659
+			if mem.Get(652) != 1005 || mem.Get(653) != 224 || mem.Get(654) != 659 {
+				break
+			}
+
+			if mem.Get(224) != 0 {
+				ip = 659
+
+				continue
+			}
+
+			ip = 655
+
+			fallthrough
+		case 655: // ADD i1 223 223
+			if computer.OverflowDetection() || mem.Get(655) != 101 || mem.Get(656) != 1 || mem.Get(657) != 223 || mem.Get(658) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(1)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 659
+
+			fallthrough
+		case 659: // EQUALS i226 677 224
+			if mem.Get(659) != 108 || mem.Get(660) != 226 || mem.Get(661) != 677 || mem.Get(662) != 224 {
+				break
+			}
+
+			if intcode.AddressValue(226) == mem.Get(677) {
+				if err := mem.Set(224, 1); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			} else {
+				if err := mem.Set(224, 0); err != nil {
+					computer.SetInstructionPointer(ip)
+
+					return err
+				}
+			}
+
+			ip = 663
+
+			fallthrough
+		case 663: // MULTIPLY i2 223 223
+			if computer.OverflowDetection() || mem.Get(663) != 102 || mem.Get(664) != 2 || mem.Get(665) != 223 || mem.Get(666) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(2)*mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 667
+
+			fallthrough
+		case 667: // JUMP-IF-FALSE 224 i674
+			if mem.Get(667) != 1006 || mem.Get(668) != 224 || mem.Get(669) != 674 {
+				break
+			}
+
+			if mem.Get(224) == 0 {
+				ip = 674
+
+				continue
+			}
+
+			ip = 670
+
+			fallthrough
+		case 670: // ADD i1 223 223
+			if computer.OverflowDetection() || mem.Get(670) != 101 || mem.Get(671) != 1 || mem.Get(672) != 223 || mem.Get(673) != 223 {
+				break
+			}
+
+			if err := mem.Set(223, intcode.AddressValue(1)+mem.Get(223)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 674
+
+			fallthrough
+		case 674: // OUTPUT 223
+			if computer.IOTimeout() != 0 || mem.Get(674) != 4 || mem.Get(675) != 223 {
+				break
+			}
+
+			computer.Output <- mem.Get(223)
+
+			ip = 676
+
+			fallthrough
+		case 676: // HALT
+			if mem.Get(676) != 99 {
+				break
+			}
+
+			computer.Halt()
+
+			return nil
+		}
+
+		// Fall back to the interpreter for a single instruction
+		computer.SetInstructionPointer(ip)
+
+		opcode, err := computer.Step()
+		if err != nil {
+			return err
+		}
+
+		if opcode == intcode.HALT {
+			computer.Halt()
+
+			return nil
+		}
+
+		ip = computer.InstructionPointer()
+	}
+}
@@ -0,0 +1,2433 @@
+// Code generated by intcode-transpile. DO NOT EDIT.
+
+package programs
+
+import "github.com/giodamelio/aoc-2020-go/intcode"
+
+// Day07 runs the intcode program from ../../../solutions/day-07/input.txt natively.
+//
+// Code the program changes at runtime is run by the interpreter.
+func Day07(computer *intcode.Computer) error {
+	mem := computer.Memory
+	ip := computer.InstructionPointer()
+	computer.State = "running"
+
+	// Policies, self modification tracking and custom instruction sets are only supported by the interpreter
+	if computer.RequiresInterpreter() {
+		for {
+			opcode, err := computer.Step()
+			if err != nil {
+				return err
+			}
+
+			if opcode == intcode.HALT {
+				computer.Halt()
+
+				return nil
+			}
+		}
+	}
+
+	for {
+		switch ip {
+		case 0: // INPUT 8
+			if computer.IOTimeout() != 0 || mem.Get(0) != 3 || mem.Get(1) != 8 {
+				break
+			}
+
+			if err := mem.Set(8, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 2
+
+			fallthrough
+		case 2: // ADD 8 i10 8
+			if computer.OverflowDetection() || mem.Get(2) != 1001 || mem.Get(3) != 8 || mem.Get(4) != 10 || mem.Get(5) != 8 {
+				break
+			}
+
+			if err := mem.Set(8, mem.Get(8)+intcode.AddressValue(10)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 6
+
+			fallthrough
+		case 6: // JUMP-IF-TRUE i1 0
+			if mem.Get(6) != 105 || mem.Get(7) != 1 || mem.Get(8) != 0 {
+				break
+			}
+
+			ip = intcode.AddressLocation(mem.Get(0))
+
+			continue
+		case 20: // HALT
+			if mem.Get(20) != 99999 {
+				break
+			}
+
+			computer.Halt()
+
+			return nil
+		case 21: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(21) != 3 || mem.Get(22) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 23
+
+			fallthrough
+		case 23: // MULTIPLY i3 9 9
+			if computer.OverflowDetection() || mem.Get(23) != 102 || mem.Get(24) != 3 || mem.Get(25) != 9 || mem.Get(26) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(3)*mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 27
+
+			fallthrough
+		case 27: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(27) != 4 || mem.Get(28) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 29
+
+			fallthrough
+		case 29: // HALT
+			if mem.Get(29) != 99 {
+				break
+			}
+
+			computer.Halt()
+
+			return nil
+		case 30: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(30) != 3 || mem.Get(31) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 32
+
+			fallthrough
+		case 32: // MULTIPLY 9 i3 9
+			if computer.OverflowDetection() || mem.Get(32) != 1002 || mem.Get(33) != 9 || mem.Get(34) != 3 || mem.Get(35) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)*intcode.AddressValue(3)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 36
+
+			fallthrough
+		case 36: // ADD 9 i5 9
+			if computer.OverflowDetection() || mem.Get(36) != 1001 || mem.Get(37) != 9 || mem.Get(38) != 5 || mem.Get(39) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(5)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 40
+
+			fallthrough
+		case 40: // MULTIPLY 9 i2 9
+			if computer.OverflowDetection() || mem.Get(40) != 1002 || mem.Get(41) != 9 || mem.Get(42) != 2 || mem.Get(43) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 44
+
+			fallthrough
+		case 44: // ADD 9 i2 9
+			if computer.OverflowDetection() || mem.Get(44) != 1001 || mem.Get(45) != 9 || mem.Get(46) != 2 || mem.Get(47) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 48
+
+			fallthrough
+		case 48: // MULTIPLY i2 9 9
+			if computer.OverflowDetection() || mem.Get(48) != 102 || mem.Get(49) != 2 || mem.Get(50) != 9 || mem.Get(51) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(2)*mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 52
+
+			fallthrough
+		case 52: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(52) != 4 || mem.Get(53) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 54
+
+			fallthrough
+		case 54: // HALT
+			if mem.Get(54) != 99 {
+				break
+			}
+
+			computer.Halt()
+
+			return nil
+		case 55: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(55) != 3 || mem.Get(56) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 57
+
+			fallthrough
+		case 57: // MULTIPLY 9 i5 9
+			if computer.OverflowDetection() || mem.Get(57) != 1002 || mem.Get(58) != 9 || mem.Get(59) != 5 || mem.Get(60) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)*intcode.AddressValue(5)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 61
+
+			fallthrough
+		case 61: // ADD 9 i2 9
+			if computer.OverflowDetection() || mem.Get(61) != 1001 || mem.Get(62) != 9 || mem.Get(63) != 2 || mem.Get(64) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 65
+
+			fallthrough
+		case 65: // MULTIPLY i5 9 9
+			if computer.OverflowDetection() || mem.Get(65) != 102 || mem.Get(66) != 5 || mem.Get(67) != 9 || mem.Get(68) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(5)*mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 69
+
+			fallthrough
+		case 69: // ADD 9 i4 9
+			if computer.OverflowDetection() || mem.Get(69) != 1001 || mem.Get(70) != 9 || mem.Get(71) != 4 || mem.Get(72) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(4)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 73
+
+			fallthrough
+		case 73: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(73) != 4 || mem.Get(74) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 75
+
+			fallthrough
+		case 75: // HALT
+			if mem.Get(75) != 99 {
+				break
+			}
+
+			computer.Halt()
+
+			return nil
+		case 76: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(76) != 3 || mem.Get(77) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 78
+
+			fallthrough
+		case 78: // ADD 9 i4 9
+			if computer.OverflowDetection() || mem.Get(78) != 1001 || mem.Get(79) != 9 || mem.Get(80) != 4 || mem.Get(81) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(4)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 82
+
+			fallthrough
+		case 82: // MULTIPLY i5 9 9
+			if computer.OverflowDetection() || mem.Get(82) != 102 || mem.Get(83) != 5 || mem.Get(84) != 9 || mem.Get(85) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(5)*mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 86
+
+			fallthrough
+		case 86: // ADD i4 9 9
+			if computer.OverflowDetection() || mem.Get(86) != 101 || mem.Get(87) != 4 || mem.Get(88) != 9 || mem.Get(89) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(4)+mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 90
+
+			fallthrough
+		case 90: // MULTIPLY 9 i4 9
+			if computer.OverflowDetection() || mem.Get(90) != 1002 || mem.Get(91) != 9 || mem.Get(92) != 4 || mem.Get(93) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)*intcode.AddressValue(4)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 94
+
+			fallthrough
+		case 94: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(94) != 4 || mem.Get(95) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 96
+
+			fallthrough
+		case 96: // HALT
+			if mem.Get(96) != 99 {
+				break
+			}
+
+			computer.Halt()
+
+			return nil
+		case 97: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(97) != 3 || mem.Get(98) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 99
+
+			fallthrough
+		case 99: // ADD i2 9 9
+			if computer.OverflowDetection() || mem.Get(99) != 101 || mem.Get(100) != 2 || mem.Get(101) != 9 || mem.Get(102) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(2)+mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 103
+
+			fallthrough
+		case 103: // MULTIPLY i4 9 9
+			if computer.OverflowDetection() || mem.Get(103) != 102 || mem.Get(104) != 4 || mem.Get(105) != 9 || mem.Get(106) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(4)*mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 107
+
+			fallthrough
+		case 107: // ADD 9 i5 9
+			if computer.OverflowDetection() || mem.Get(107) != 1001 || mem.Get(108) != 9 || mem.Get(109) != 5 || mem.Get(110) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(5)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 111
+
+			fallthrough
+		case 111: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(111) != 4 || mem.Get(112) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 113
+
+			fallthrough
+		case 113: // HALT
+			if mem.Get(113) != 99 {
+				break
+			}
+
+			computer.Halt()
+
+			return nil
+		case 114: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(114) != 3 || mem.Get(115) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 116
+
+			fallthrough
+		case 116: // MULTIPLY 9 i2 9
+			if computer.OverflowDetection() || mem.Get(116) != 1002 || mem.Get(117) != 9 || mem.Get(118) != 2 || mem.Get(119) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 120
+
+			fallthrough
+		case 120: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(120) != 4 || mem.Get(121) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 122
+
+			fallthrough
+		case 122: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(122) != 3 || mem.Get(123) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 124
+
+			fallthrough
+		case 124: // MULTIPLY i2 9 9
+			if computer.OverflowDetection() || mem.Get(124) != 102 || mem.Get(125) != 2 || mem.Get(126) != 9 || mem.Get(127) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(2)*mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 128
+
+			fallthrough
+		case 128: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(128) != 4 || mem.Get(129) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 130
+
+			fallthrough
+		case 130: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(130) != 3 || mem.Get(131) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 132
+
+			fallthrough
+		case 132: // MULTIPLY i2 9 9
+			if computer.OverflowDetection() || mem.Get(132) != 102 || mem.Get(133) != 2 || mem.Get(134) != 9 || mem.Get(135) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(2)*mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 136
+
+			fallthrough
+		case 136: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(136) != 4 || mem.Get(137) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 138
+
+			fallthrough
+		case 138: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(138) != 3 || mem.Get(139) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 140
+
+			fallthrough
+		case 140: // ADD 9 i1 9
+			if computer.OverflowDetection() || mem.Get(140) != 1001 || mem.Get(141) != 9 || mem.Get(142) != 1 || mem.Get(143) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(1)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 144
+
+			fallthrough
+		case 144: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(144) != 4 || mem.Get(145) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 146
+
+			fallthrough
+		case 146: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(146) != 3 || mem.Get(147) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 148
+
+			fallthrough
+		case 148: // MULTIPLY i2 9 9
+			if computer.OverflowDetection() || mem.Get(148) != 102 || mem.Get(149) != 2 || mem.Get(150) != 9 || mem.Get(151) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(2)*mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 152
+
+			fallthrough
+		case 152: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(152) != 4 || mem.Get(153) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 154
+
+			fallthrough
+		case 154: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(154) != 3 || mem.Get(155) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 156
+
+			fallthrough
+		case 156: // MULTIPLY 9 i2 9
+			if computer.OverflowDetection() || mem.Get(156) != 1002 || mem.Get(157) != 9 || mem.Get(158) != 2 || mem.Get(159) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 160
+
+			fallthrough
+		case 160: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(160) != 4 || mem.Get(161) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 162
+
+			fallthrough
+		case 162: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(162) != 3 || mem.Get(163) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 164
+
+			fallthrough
+		case 164: // MULTIPLY i2 9 9
+			if computer.OverflowDetection() || mem.Get(164) != 102 || mem.Get(165) != 2 || mem.Get(166) != 9 || mem.Get(167) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(2)*mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 168
+
+			fallthrough
+		case 168: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(168) != 4 || mem.Get(169) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 170
+
+			fallthrough
+		case 170: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(170) != 3 || mem.Get(171) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 172
+
+			fallthrough
+		case 172: // MULTIPLY i2 9 9
+			if computer.OverflowDetection() || mem.Get(172) != 102 || mem.Get(173) != 2 || mem.Get(174) != 9 || mem.Get(175) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(2)*mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 176
+
+			fallthrough
+		case 176: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(176) != 4 || mem.Get(177) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 178
+
+			fallthrough
+		case 178: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(178) != 3 || mem.Get(179) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 180
+
+			fallthrough
+		case 180: // ADD 9 i2 9
+			if computer.OverflowDetection() || mem.Get(180) != 1001 || mem.Get(181) != 9 || mem.Get(182) != 2 || mem.Get(183) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 184
+
+			fallthrough
+		case 184: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(184) != 4 || mem.Get(185) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 186
+
+			fallthrough
+		case 186: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(186) != 3 || mem.Get(187) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 188
+
+			fallthrough
+		case 188: // ADD i1 9 9
+			if computer.OverflowDetection() || mem.Get(188) != 101 || mem.Get(189) != 1 || mem.Get(190) != 9 || mem.Get(191) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(1)+mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 192
+
+			fallthrough
+		case 192: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(192) != 4 || mem.Get(193) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 194
+
+			fallthrough
+		case 194: // HALT
+			if mem.Get(194) != 99 {
+				break
+			}
+
+			computer.Halt()
+
+			return nil
+		case 195: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(195) != 3 || mem.Get(196) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 197
+
+			fallthrough
+		case 197: // MULTIPLY 9 i2 9
+			if computer.OverflowDetection() || mem.Get(197) != 1002 || mem.Get(198) != 9 || mem.Get(199) != 2 || mem.Get(200) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 201
+
+			fallthrough
+		case 201: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(201) != 4 || mem.Get(202) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 203
+
+			fallthrough
+		case 203: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(203) != 3 || mem.Get(204) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 205
+
+			fallthrough
+		case 205: // ADD 9 i2 9
+			if computer.OverflowDetection() || mem.Get(205) != 1001 || mem.Get(206) != 9 || mem.Get(207) != 2 || mem.Get(208) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 209
+
+			fallthrough
+		case 209: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(209) != 4 || mem.Get(210) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 211
+
+			fallthrough
+		case 211: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(211) != 3 || mem.Get(212) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 213
+
+			fallthrough
+		case 213: // ADD i1 9 9
+			if computer.OverflowDetection() || mem.Get(213) != 101 || mem.Get(214) != 1 || mem.Get(215) != 9 || mem.Get(216) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(1)+mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 217
+
+			fallthrough
+		case 217: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(217) != 4 || mem.Get(218) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 219
+
+			fallthrough
+		case 219: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(219) != 3 || mem.Get(220) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 221
+
+			fallthrough
+		case 221: // MULTIPLY 9 i2 9
+			if computer.OverflowDetection() || mem.Get(221) != 1002 || mem.Get(222) != 9 || mem.Get(223) != 2 || mem.Get(224) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 225
+
+			fallthrough
+		case 225: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(225) != 4 || mem.Get(226) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 227
+
+			fallthrough
+		case 227: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(227) != 3 || mem.Get(228) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 229
+
+			fallthrough
+		case 229: // ADD 9 i2 9
+			if computer.OverflowDetection() || mem.Get(229) != 1001 || mem.Get(230) != 9 || mem.Get(231) != 2 || mem.Get(232) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 233
+
+			fallthrough
+		case 233: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(233) != 4 || mem.Get(234) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 235
+
+			fallthrough
+		case 235: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(235) != 3 || mem.Get(236) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 237
+
+			fallthrough
+		case 237: // ADD 9 i2 9
+			if computer.OverflowDetection() || mem.Get(237) != 1001 || mem.Get(238) != 9 || mem.Get(239) != 2 || mem.Get(240) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 241
+
+			fallthrough
+		case 241: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(241) != 4 || mem.Get(242) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 243
+
+			fallthrough
+		case 243: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(243) != 3 || mem.Get(244) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 245
+
+			fallthrough
+		case 245: // MULTIPLY 9 i2 9
+			if computer.OverflowDetection() || mem.Get(245) != 1002 || mem.Get(246) != 9 || mem.Get(247) != 2 || mem.Get(248) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 249
+
+			fallthrough
+		case 249: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(249) != 4 || mem.Get(250) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 251
+
+			fallthrough
+		case 251: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(251) != 3 || mem.Get(252) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 253
+
+			fallthrough
+		case 253: // ADD 9 i2 9
+			if computer.OverflowDetection() || mem.Get(253) != 1001 || mem.Get(254) != 9 || mem.Get(255) != 2 || mem.Get(256) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 257
+
+			fallthrough
+		case 257: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(257) != 4 || mem.Get(258) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 259
+
+			fallthrough
+		case 259: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(259) != 3 || mem.Get(260) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 261
+
+			fallthrough
+		case 261: // ADD i2 9 9
+			if computer.OverflowDetection() || mem.Get(261) != 101 || mem.Get(262) != 2 || mem.Get(263) != 9 || mem.Get(264) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(2)+mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 265
+
+			fallthrough
+		case 265: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(265) != 4 || mem.Get(266) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 267
+
+			fallthrough
+		case 267: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(267) != 3 || mem.Get(268) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 269
+
+			fallthrough
+		case 269: // MULTIPLY i2 9 9
+			if computer.OverflowDetection() || mem.Get(269) != 102 || mem.Get(270) != 2 || mem.Get(271) != 9 || mem.Get(272) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(2)*mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 273
+
+			fallthrough
+		case 273: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(273) != 4 || mem.Get(274) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 275
+
+			fallthrough
+		case 275: // HALT
+			if mem.Get(275) != 99 {
+				break
+			}
+
+			computer.Halt()
+
+			return nil
+		case 276: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(276) != 3 || mem.Get(277) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 278
+
+			fallthrough
+		case 278: // ADD i1 9 9
+			if computer.OverflowDetection() || mem.Get(278) != 101 || mem.Get(279) != 1 || mem.Get(280) != 9 || mem.Get(281) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(1)+mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 282
+
+			fallthrough
+		case 282: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(282) != 4 || mem.Get(283) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 284
+
+			fallthrough
+		case 284: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(284) != 3 || mem.Get(285) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 286
+
+			fallthrough
+		case 286: // MULTIPLY 9 i2 9
+			if computer.OverflowDetection() || mem.Get(286) != 1002 || mem.Get(287) != 9 || mem.Get(288) != 2 || mem.Get(289) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 290
+
+			fallthrough
+		case 290: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(290) != 4 || mem.Get(291) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 292
+
+			fallthrough
+		case 292: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(292) != 3 || mem.Get(293) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 294
+
+			fallthrough
+		case 294: // ADD 9 i1 9
+			if computer.OverflowDetection() || mem.Get(294) != 1001 || mem.Get(295) != 9 || mem.Get(296) != 1 || mem.Get(297) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(1)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 298
+
+			fallthrough
+		case 298: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(298) != 4 || mem.Get(299) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 300
+
+			fallthrough
+		case 300: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(300) != 3 || mem.Get(301) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 302
+
+			fallthrough
+		case 302: // MULTIPLY 9 i2 9
+			if computer.OverflowDetection() || mem.Get(302) != 1002 || mem.Get(303) != 9 || mem.Get(304) != 2 || mem.Get(305) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 306
+
+			fallthrough
+		case 306: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(306) != 4 || mem.Get(307) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 308
+
+			fallthrough
+		case 308: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(308) != 3 || mem.Get(309) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 310
+
+			fallthrough
+		case 310: // ADD 9 i1 9
+			if computer.OverflowDetection() || mem.Get(310) != 1001 || mem.Get(311) != 9 || mem.Get(312) != 1 || mem.Get(313) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(1)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 314
+
+			fallthrough
+		case 314: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(314) != 4 || mem.Get(315) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 316
+
+			fallthrough
+		case 316: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(316) != 3 || mem.Get(317) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 318
+
+			fallthrough
+		case 318: // ADD 9 i1 9
+			if computer.OverflowDetection() || mem.Get(318) != 1001 || mem.Get(319) != 9 || mem.Get(320) != 1 || mem.Get(321) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(1)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 322
+
+			fallthrough
+		case 322: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(322) != 4 || mem.Get(323) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 324
+
+			fallthrough
+		case 324: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(324) != 3 || mem.Get(325) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 326
+
+			fallthrough
+		case 326: // ADD i1 9 9
+			if computer.OverflowDetection() || mem.Get(326) != 101 || mem.Get(327) != 1 || mem.Get(328) != 9 || mem.Get(329) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(1)+mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 330
+
+			fallthrough
+		case 330: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(330) != 4 || mem.Get(331) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 332
+
+			fallthrough
+		case 332: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(332) != 3 || mem.Get(333) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 334
+
+			fallthrough
+		case 334: // MULTIPLY i2 9 9
+			if computer.OverflowDetection() || mem.Get(334) != 102 || mem.Get(335) != 2 || mem.Get(336) != 9 || mem.Get(337) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(2)*mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 338
+
+			fallthrough
+		case 338: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(338) != 4 || mem.Get(339) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 340
+
+			fallthrough
+		case 340: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(340) != 3 || mem.Get(341) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 342
+
+			fallthrough
+		case 342: // ADD 9 i1 9
+			if computer.OverflowDetection() || mem.Get(342) != 1001 || mem.Get(343) != 9 || mem.Get(344) != 1 || mem.Get(345) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(1)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 346
+
+			fallthrough
+		case 346: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(346) != 4 || mem.Get(347) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 348
+
+			fallthrough
+		case 348: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(348) != 3 || mem.Get(349) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 350
+
+			fallthrough
+		case 350: // ADD 9 i1 9
+			if computer.OverflowDetection() || mem.Get(350) != 1001 || mem.Get(351) != 9 || mem.Get(352) != 1 || mem.Get(353) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(1)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 354
+
+			fallthrough
+		case 354: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(354) != 4 || mem.Get(355) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 356
+
+			fallthrough
+		case 356: // HALT
+			if mem.Get(356) != 99 {
+				break
+			}
+
+			computer.Halt()
+
+			return nil
+		case 357: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(357) != 3 || mem.Get(358) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 359
+
+			fallthrough
+		case 359: // ADD 9 i1 9
+			if computer.OverflowDetection() || mem.Get(359) != 1001 || mem.Get(360) != 9 || mem.Get(361) != 1 || mem.Get(362) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(1)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 363
+
+			fallthrough
+		case 363: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(363) != 4 || mem.Get(364) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 365
+
+			fallthrough
+		case 365: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(365) != 3 || mem.Get(366) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 367
+
+			fallthrough
+		case 367: // MULTIPLY 9 i2 9
+			if computer.OverflowDetection() || mem.Get(367) != 1002 || mem.Get(368) != 9 || mem.Get(369) != 2 || mem.Get(370) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 371
+
+			fallthrough
+		case 371: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(371) != 4 || mem.Get(372) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 373
+
+			fallthrough
+		case 373: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(373) != 3 || mem.Get(374) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 375
+
+			fallthrough
+		case 375: // ADD 9 i2 9
+			if computer.OverflowDetection() || mem.Get(375) != 1001 || mem.Get(376) != 9 || mem.Get(377) != 2 || mem.Get(378) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 379
+
+			fallthrough
+		case 379: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(379) != 4 || mem.Get(380) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 381
+
+			fallthrough
+		case 381: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(381) != 3 || mem.Get(382) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 383
+
+			fallthrough
+		case 383: // MULTIPLY 9 i2 9
+			if computer.OverflowDetection() || mem.Get(383) != 1002 || mem.Get(384) != 9 || mem.Get(385) != 2 || mem.Get(386) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 387
+
+			fallthrough
+		case 387: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(387) != 4 || mem.Get(388) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 389
+
+			fallthrough
+		case 389: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(389) != 3 || mem.Get(390) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 391
+
+			fallthrough
+		case 391: // ADD 9 i1 9
+			if computer.OverflowDetection() || mem.Get(391) != 1001 || mem.Get(392) != 9 || mem.Get(393) != 1 || mem.Get(394) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(1)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 395
+
+			fallthrough
+		case 395: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(395) != 4 || mem.Get(396) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 397
+
+			fallthrough
+		case 397: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(397) != 3 || mem.Get(398) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 399
+
+			fallthrough
+		case 399: // ADD i2 9 9
+			if computer.OverflowDetection() || mem.Get(399) != 101 || mem.Get(400) != 2 || mem.Get(401) != 9 || mem.Get(402) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(2)+mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 403
+
+			fallthrough
+		case 403: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(403) != 4 || mem.Get(404) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 405
+
+			fallthrough
+		case 405: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(405) != 3 || mem.Get(406) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 407
+
+			fallthrough
+		case 407: // MULTIPLY i2 9 9
+			if computer.OverflowDetection() || mem.Get(407) != 102 || mem.Get(408) != 2 || mem.Get(409) != 9 || mem.Get(410) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(2)*mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 411
+
+			fallthrough
+		case 411: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(411) != 4 || mem.Get(412) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 413
+
+			fallthrough
+		case 413: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(413) != 3 || mem.Get(414) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 415
+
+			fallthrough
+		case 415: // ADD i2 9 9
+			if computer.OverflowDetection() || mem.Get(415) != 101 || mem.Get(416) != 2 || mem.Get(417) != 9 || mem.Get(418) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(2)+mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 419
+
+			fallthrough
+		case 419: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(419) != 4 || mem.Get(420) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 421
+
+			fallthrough
+		case 421: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(421) != 3 || mem.Get(422) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 423
+
+			fallthrough
+		case 423: // ADD i1 9 9
+			if computer.OverflowDetection() || mem.Get(423) != 101 || mem.Get(424) != 1 || mem.Get(425) != 9 || mem.Get(426) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(1)+mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 427
+
+			fallthrough
+		case 427: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(427) != 4 || mem.Get(428) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 429
+
+			fallthrough
+		case 429: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(429) != 3 || mem.Get(430) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 431
+
+			fallthrough
+		case 431: // ADD 9 i1 9
+			if computer.OverflowDetection() || mem.Get(431) != 1001 || mem.Get(432) != 9 || mem.Get(433) != 1 || mem.Get(434) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(1)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 435
+
+			fallthrough
+		case 435: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(435) != 4 || mem.Get(436) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 437
+
+			fallthrough
+		case 437: // HALT
+			if mem.Get(437) != 99 {
+				break
+			}
+
+			computer.Halt()
+
+			return nil
+		case 438: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(438) != 3 || mem.Get(439) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 440
+
+			fallthrough
+		case 440: // ADD i2 9 9
+			if computer.OverflowDetection() || mem.Get(440) != 101 || mem.Get(441) != 2 || mem.Get(442) != 9 || mem.Get(443) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(2)+mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 444
+
+			fallthrough
+		case 444: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(444) != 4 || mem.Get(445) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 446
+
+			fallthrough
+		case 446: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(446) != 3 || mem.Get(447) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 448
+
+			fallthrough
+		case 448: // MULTIPLY i2 9 9
+			if computer.OverflowDetection() || mem.Get(448) != 102 || mem.Get(449) != 2 || mem.Get(450) != 9 || mem.Get(451) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(2)*mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 452
+
+			fallthrough
+		case 452: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(452) != 4 || mem.Get(453) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 454
+
+			fallthrough
+		case 454: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(454) != 3 || mem.Get(455) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 456
+
+			fallthrough
+		case 456: // ADD i2 9 9
+			if computer.OverflowDetection() || mem.Get(456) != 101 || mem.Get(457) != 2 || mem.Get(458) != 9 || mem.Get(459) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(2)+mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 460
+
+			fallthrough
+		case 460: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(460) != 4 || mem.Get(461) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 462
+
+			fallthrough
+		case 462: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(462) != 3 || mem.Get(463) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 464
+
+			fallthrough
+		case 464: // ADD i1 9 9
+			if computer.OverflowDetection() || mem.Get(464) != 101 || mem.Get(465) != 1 || mem.Get(466) != 9 || mem.Get(467) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(1)+mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 468
+
+			fallthrough
+		case 468: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(468) != 4 || mem.Get(469) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 470
+
+			fallthrough
+		case 470: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(470) != 3 || mem.Get(471) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 472
+
+			fallthrough
+		case 472: // ADD i2 9 9
+			if computer.OverflowDetection() || mem.Get(472) != 101 || mem.Get(473) != 2 || mem.Get(474) != 9 || mem.Get(475) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(2)+mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 476
+
+			fallthrough
+		case 476: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(476) != 4 || mem.Get(477) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 478
+
+			fallthrough
+		case 478: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(478) != 3 || mem.Get(479) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 480
+
+			fallthrough
+		case 480: // MULTIPLY 9 i2 9
+			if computer.OverflowDetection() || mem.Get(480) != 1002 || mem.Get(481) != 9 || mem.Get(482) != 2 || mem.Get(483) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)*intcode.AddressValue(2)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 484
+
+			fallthrough
+		case 484: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(484) != 4 || mem.Get(485) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 486
+
+			fallthrough
+		case 486: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(486) != 3 || mem.Get(487) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 488
+
+			fallthrough
+		case 488: // MULTIPLY i2 9 9
+			if computer.OverflowDetection() || mem.Get(488) != 102 || mem.Get(489) != 2 || mem.Get(490) != 9 || mem.Get(491) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(2)*mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 492
+
+			fallthrough
+		case 492: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(492) != 4 || mem.Get(493) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 494
+
+			fallthrough
+		case 494: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(494) != 3 || mem.Get(495) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 496
+
+			fallthrough
+		case 496: // ADD 9 i1 9
+			if computer.OverflowDetection() || mem.Get(496) != 1001 || mem.Get(497) != 9 || mem.Get(498) != 1 || mem.Get(499) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(1)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 500
+
+			fallthrough
+		case 500: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(500) != 4 || mem.Get(501) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 502
+
+			fallthrough
+		case 502: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(502) != 3 || mem.Get(503) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 504
+
+			fallthrough
+		case 504: // ADD 9 i1 9
+			if computer.OverflowDetection() || mem.Get(504) != 1001 || mem.Get(505) != 9 || mem.Get(506) != 1 || mem.Get(507) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, mem.Get(9)+intcode.AddressValue(1)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 508
+
+			fallthrough
+		case 508: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(508) != 4 || mem.Get(509) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 510
+
+			fallthrough
+		case 510: // INPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(510) != 3 || mem.Get(511) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 512
+
+			fallthrough
+		case 512: // ADD i2 9 9
+			if computer.OverflowDetection() || mem.Get(512) != 101 || mem.Get(513) != 2 || mem.Get(514) != 9 || mem.Get(515) != 9 {
+				break
+			}
+
+			if err := mem.Set(9, intcode.AddressValue(2)+mem.Get(9)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 516
+
+			fallthrough
+		case 516: // OUTPUT 9
+			if computer.IOTimeout() != 0 || mem.Get(516) != 4 || mem.Get(517) != 9 {
+				break
+			}
+
+			computer.Output <- mem.Get(9)
+
+			ip = 518
+
+			fallthrough
+		case 518: // HALT
+			if mem.Get(518) != 99 {
+				break
+			}
+
+			computer.Halt()
+
+			return nil
+		}
+
+		// Fall back to the interpreter for a single instruction
+		computer.SetInstructionPointer(ip)
+
+		opcode, err := computer.Step()
+		if err != nil {
+			return err
+		}
+
+		if opcode == intcode.HALT {
+			computer.Halt()
+
+			return nil
+		}
+
+		ip = computer.InstructionPointer()
+	}
+}
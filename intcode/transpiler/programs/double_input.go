@@ -0,0 +1,97 @@
+// Code generated by intcode-transpile. DO NOT EDIT.
+
+package programs
+
+import "github.com/giodamelio/aoc-2020-go/intcode"
+
+// DoubleInput runs the intcode program from testdata/double-input.txt natively.
+//
+// Code the program changes at runtime is run by the interpreter.
+func DoubleInput(computer *intcode.Computer) error {
+	mem := computer.Memory
+	ip := computer.InstructionPointer()
+	computer.State = "running"
+
+	// Policies, self modification tracking and custom instruction sets are only supported by the interpreter
+	if computer.RequiresInterpreter() {
+		for {
+			opcode, err := computer.Step()
+			if err != nil {
+				return err
+			}
+
+			if opcode == intcode.HALT {
+				computer.Halt()
+
+				return nil
+			}
+		}
+	}
+
+	for {
+		switch ip {
+		case 0: // INPUT 0
+			if computer.IOTimeout() != 0 || mem.Get(0) != 3 || mem.Get(1) != 0 {
+				break
+			}
+
+			if err := mem.Set(0, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 2
+
+			fallthrough
+		case 2: // MULTIPLY 2 0 0
+			if computer.OverflowDetection() || mem.Get(2) != 2 || mem.Get(3) != 2 || mem.Get(4) != 0 || mem.Get(5) != 0 {
+				break
+			}
+
+			if err := mem.Set(0, mem.Get(2)*mem.Get(0)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 6
+
+			fallthrough
+		case 6: // OUTPUT 0
+			if computer.IOTimeout() != 0 || mem.Get(6) != 4 || mem.Get(7) != 0 {
+				break
+			}
+
+			computer.Output <- mem.Get(0)
+
+			ip = 8
+
+			fallthrough
+		case 8: // HALT
+			if mem.Get(8) != 99 {
+				break
+			}
+
+			computer.Halt()
+
+			return nil
+		}
+
+		// Fall back to the interpreter for a single instruction
+		computer.SetInstructionPointer(ip)
+
+		opcode, err := computer.Step()
+		if err != nil {
+			return err
+		}
+
+		if opcode == intcode.HALT {
+			computer.Halt()
+
+			return nil
+		}
+
+		ip = computer.InstructionPointer()
+	}
+}
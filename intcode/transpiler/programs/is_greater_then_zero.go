@@ -0,0 +1,111 @@
+// Code generated by intcode-transpile. DO NOT EDIT.
+
+package programs
+
+import "github.com/giodamelio/aoc-2020-go/intcode"
+
+// IsGreaterThenZero runs the intcode program from testdata/is-greater-then-zero.txt natively.
+//
+// Code the program changes at runtime is run by the interpreter.
+func IsGreaterThenZero(computer *intcode.Computer) error {
+	mem := computer.Memory
+	ip := computer.InstructionPointer()
+	computer.State = "running"
+
+	// Policies, self modification tracking and custom instruction sets are only supported by the interpreter
+	if computer.RequiresInterpreter() {
+		for {
+			opcode, err := computer.Step()
+			if err != nil {
+				return err
+			}
+
+			if opcode == intcode.HALT {
+				computer.Halt()
+
+				return nil
+			}
+		}
+	}
+
+	for {
+		switch ip {
+		case 0: // INPUT 12
+			if computer.IOTimeout() != 0 || mem.Get(0) != 3 || mem.Get(1) != 12 {
+				break
+			}
+
+			if err := mem.Set(12, <-computer.Input); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 2
+
+			fallthrough
+		case 2: // JUMP-IF-FALSE 12 15
+			if mem.Get(2) != 6 || mem.Get(3) != 12 || mem.Get(4) != 15 {
+				break
+			}
+
+			if mem.Get(12) == 0 {
+				ip = intcode.AddressLocation(mem.Get(15))
+
+				continue
+			}
+
+			ip = 5
+
+			fallthrough
+		case 5: // ADD 13 14 13
+			if computer.OverflowDetection() || mem.Get(5) != 1 || mem.Get(6) != 13 || mem.Get(7) != 14 || mem.Get(8) != 13 {
+				break
+			}
+
+			if err := mem.Set(13, mem.Get(13)+mem.Get(14)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 9
+
+			fallthrough
+		case 9: // OUTPUT 13
+			if computer.IOTimeout() != 0 || mem.Get(9) != 4 || mem.Get(10) != 13 {
+				break
+			}
+
+			computer.Output <- mem.Get(13)
+
+			ip = 11
+
+			fallthrough
+		case 11: // HALT
+			if mem.Get(11) != 99 {
+				break
+			}
+
+			computer.Halt()
+
+			return nil
+		}
+
+		// Fall back to the interpreter for a single instruction
+		computer.SetInstructionPointer(ip)
+
+		opcode, err := computer.Step()
+		if err != nil {
+			return err
+		}
+
+		if opcode == intcode.HALT {
+			computer.Halt()
+
+			return nil
+		}
+
+		ip = computer.InstructionPointer()
+	}
+}
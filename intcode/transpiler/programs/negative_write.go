@@ -0,0 +1,73 @@
+// Code generated by intcode-transpile. DO NOT EDIT.
+
+package programs
+
+import "github.com/giodamelio/aoc-2020-go/intcode"
+
+// NegativeWrite runs the intcode program from testdata/negative-write.txt natively.
+//
+// Code the program changes at runtime is run by the interpreter.
+func NegativeWrite(computer *intcode.Computer) error {
+	mem := computer.Memory
+	ip := computer.InstructionPointer()
+	computer.State = "running"
+
+	// Policies, self modification tracking and custom instruction sets are only supported by the interpreter
+	if computer.RequiresInterpreter() {
+		for {
+			opcode, err := computer.Step()
+			if err != nil {
+				return err
+			}
+
+			if opcode == intcode.HALT {
+				computer.Halt()
+
+				return nil
+			}
+		}
+	}
+
+	for {
+		switch ip {
+		case 0: // ADD i2 i3 -1
+			if computer.OverflowDetection() || mem.Get(0) != 1101 || mem.Get(1) != 2 || mem.Get(2) != 3 || mem.Get(3) != -1 {
+				break
+			}
+
+			if err := mem.Set(-1, intcode.AddressValue(2)+intcode.AddressValue(3)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 4
+
+			fallthrough
+		case 4: // HALT
+			if mem.Get(4) != 99 {
+				break
+			}
+
+			computer.Halt()
+
+			return nil
+		}
+
+		// Fall back to the interpreter for a single instruction
+		computer.SetInstructionPointer(ip)
+
+		opcode, err := computer.Step()
+		if err != nil {
+			return err
+		}
+
+		if opcode == intcode.HALT {
+			computer.Halt()
+
+			return nil
+		}
+
+		ip = computer.InstructionPointer()
+	}
+}
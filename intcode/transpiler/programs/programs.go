@@ -0,0 +1,11 @@
+// Package programs holds native versions of the intcode programs used in the tests.
+//
+// They are checked against the interpreter in the tests, regenerate them with go generate.
+package programs
+
+//go:generate go run ../../../cmd/intcode-transpile -in ../../../solutions/day-02/input.txt -package programs -func Day02 -out day02.go
+//go:generate go run ../../../cmd/intcode-transpile -in ../../../solutions/day-05/input.txt -package programs -func Day05 -out day05.go
+//go:generate go run ../../../cmd/intcode-transpile -in ../../../solutions/day-07/input.txt -package programs -func Day07 -out day07.go
+//go:generate go run ../../../cmd/intcode-transpile -in testdata/double-input.txt -package programs -func DoubleInput -out double_input.go
+//go:generate go run ../../../cmd/intcode-transpile -in testdata/is-greater-then-zero.txt -package programs -func IsGreaterThenZero -out is_greater_then_zero.go
+//go:generate go run ../../../cmd/intcode-transpile -in testdata/negative-write.txt -package programs -func NegativeWrite -out negative_write.go
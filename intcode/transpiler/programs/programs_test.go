@@ -0,0 +1,295 @@
+package programs
+
+import (
+	"io/ioutil"
+	"math"
+	"testing"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/intcode/transpiler"
+	"github.com/stretchr/testify/assert"
+)
+
+type runner func(*intcode.Computer) error
+
+func interpret(computer *intcode.Computer) error {
+	computer.Run()
+
+	return nil
+}
+
+func loadProgram(t *testing.T, path string) []intcode.AddressValue {
+	rawProgram, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+
+	program, err := intcode.ParseInput(string(rawProgram))
+	assert.Nil(t, err)
+
+	return program
+}
+
+// Run a program to completion returning everything it output and its final memory.
+func execute(
+	t *testing.T,
+	run runner,
+	program []intcode.AddressValue,
+	patches map[intcode.AddressValue]intcode.AddressValue,
+	inputs ...intcode.AddressValue,
+) ([]intcode.AddressValue, []intcode.AddressValue) {
+	computer := intcode.NewComputer(program)
+
+	for address, value := range patches {
+		computer.Memory.Set(address, value)
+	}
+
+	// Queue up every input ahead of time so nothing ever sends on a halted computer
+	computer.Input = make(chan intcode.AddressValue, len(inputs))
+	for _, input := range inputs {
+		computer.Input <- input
+	}
+
+	allOutputs := make(chan []intcode.AddressValue)
+
+	go func() {
+		var outputs []intcode.AddressValue
+		for output := range computer.Output {
+			outputs = append(outputs, output)
+		}
+
+		allOutputs <- outputs
+	}()
+
+	assert.Nil(t, run(computer))
+	assert.Equal(t, "halted", computer.State)
+
+	outputs := <-allOutputs
+	memory := computer.Memory.GetRange(0, int64(len(program)))
+
+	return outputs, memory
+}
+
+// Make sure the native and interpreted versions agree on outputs and memory.
+func assertSame(
+	t *testing.T,
+	native runner,
+	program []intcode.AddressValue,
+	patches map[intcode.AddressValue]intcode.AddressValue,
+	inputs ...intcode.AddressValue,
+) []intcode.AddressValue {
+	expectedOutputs, expectedMemory := execute(t, interpret, program, patches, inputs...)
+	outputs, memory := execute(t, native, program, patches, inputs...)
+
+	assert.Equal(t, expectedOutputs, outputs)
+	assert.Equal(t, expectedMemory, memory)
+
+	return outputs
+}
+
+func TestDay02(t *testing.T) {
+	program := loadProgram(t, "../../../solutions/day-02/input.txt")
+
+	for _, nounVerb := range [][2]intcode.AddressValue{{12, 2}, {20, 3}, {0, 0}, {99, 99}} {
+		assertSame(t, Day02, program, map[intcode.AddressValue]intcode.AddressValue{
+			1: nounVerb[0],
+			2: nounVerb[1],
+		})
+	}
+
+	_, memory := execute(t, Day02, program, map[intcode.AddressValue]intcode.AddressValue{1: 12, 2: 2})
+	assert.Equal(t, intcode.AddressValue(12490719), memory[0])
+}
+
+func TestDay05(t *testing.T) {
+	program := loadProgram(t, "../../../solutions/day-05/input.txt")
+
+	outputs := assertSame(t, Day05, program, nil, 1)
+	assert.Equal(t, intcode.AddressValue(4511442), outputs[len(outputs)-1])
+
+	outputs = assertSame(t, Day05, program, nil, 5)
+	assert.Equal(t, []intcode.AddressValue{12648139}, outputs)
+}
+
+func TestDay07(t *testing.T) {
+	program := loadProgram(t, "../../../solutions/day-07/input.txt")
+
+	for phase := intcode.AddressValue(0); phase <= 4; phase++ {
+		assertSame(t, Day07, program, nil, phase, 17)
+	}
+
+	// Feedback mode keeps asking for signals until it is done
+	signals := make([]intcode.AddressValue, 20)
+	for i := range signals {
+		signals[i] = intcode.AddressValue(i * 3)
+	}
+
+	for phase := intcode.AddressValue(5); phase <= 9; phase++ {
+		assertSame(t, Day07, program, nil, append([]intcode.AddressValue{phase}, signals...)...)
+	}
+}
+
+func TestOverflowDetection(t *testing.T) {
+	program := loadProgram(t, "testdata/double-input.txt")
+
+	computer := intcode.NewComputer(program)
+	computer.SetOverflowDetection(true)
+	computer.Input = make(chan intcode.AddressValue, 1)
+	computer.Input <- math.MaxInt64
+
+	err := DoubleInput(computer)
+
+	assert.Equal(t, "integer overflow in MULTIPLY at 2: 2 and 9223372036854775807 do not fit in 64 bits", err.Error())
+}
+
+func TestPolicy(t *testing.T) {
+	program := loadProgram(t, "testdata/double-input.txt")
+
+	computer := intcode.NewComputer(program)
+	computer.SetPolicy(&intcode.Policy{MaxInstructions: 1})
+	computer.Input = make(chan intcode.AddressValue, 1)
+	computer.Input <- 1
+
+	err := DoubleInput(computer)
+
+	assert.Equal(t, "policy violation at 2: instruction limit of 1 reached", err.Error())
+}
+
+func TestCustomInstructionSet(t *testing.T) {
+	program := loadProgram(t, "testdata/double-input.txt")
+
+	// Make OUTPUT send the negated value
+	negate := intcode.DefaultInstructionSet()
+	output, _ := negate.Get(intcode.OUTPUT)
+	send := output.Execute
+	output.Execute = func(computer *intcode.Computer, operation intcode.Opcode, parameters []intcode.AddressValue) error {
+		return send(computer, operation, []intcode.AddressValue{-parameters[0]})
+	}
+	assert.Nil(t, negate.Override(output))
+
+	computer := intcode.NewComputer(program)
+	computer.SetInstructionSet(negate)
+	computer.Input = make(chan intcode.AddressValue, 1)
+	computer.Input <- 11
+	computer.Output = make(chan intcode.AddressValue, 1)
+
+	assert.Nil(t, DoubleInput(computer))
+	assert.Equal(t, intcode.AddressValue(-22), <-computer.Output)
+}
+
+func TestProfile(t *testing.T) {
+	program := loadProgram(t, "testdata/double-input.txt")
+
+	computer := intcode.NewComputerWithProfile(program, intcode.Day2Profile)
+	computer.Input = make(chan intcode.AddressValue, 1)
+	computer.Input <- 11
+
+	err := DoubleInput(computer)
+
+	assert.Equal(t, "opcode 3 (INPUT) is not available in the day-2 profile", err.Error())
+}
+
+func TestSelfModification(t *testing.T) {
+	program := loadProgram(t, "../../../solutions/day-02/input.txt")
+
+	computer := intcode.NewComputer(program)
+	computer.Memory.Set(1, 12)
+	computer.Memory.Set(2, 2)
+	computer.TrackSelfModification(intcode.CountSelfModification)
+
+	assert.Nil(t, Day02(computer))
+
+	// Every instruction stores its result over an instruction that already ran, ending with the
+	// answer being written over the first one
+	writes := computer.CodeWrites()
+	last := writes[len(writes)-1]
+	assert.Len(t, writes, 33)
+	assert.Equal(t, intcode.AddressLocation(0), last.Address)
+	assert.Equal(t, computer.Memory.Get(0), last.New)
+}
+
+func TestDoubleInput(t *testing.T) {
+	program := loadProgram(t, "testdata/double-input.txt")
+
+	outputs := assertSame(t, DoubleInput, program, nil, 11)
+	assert.Equal(t, []intcode.AddressValue{22}, outputs)
+}
+
+func TestIsGreaterThenZero(t *testing.T) {
+	program := loadProgram(t, "testdata/is-greater-then-zero.txt")
+
+	assert.Equal(t, []intcode.AddressValue{1}, assertSame(t, IsGreaterThenZero, program, nil, 22))
+	assert.Equal(t, []intcode.AddressValue{0}, assertSame(t, IsGreaterThenZero, program, nil, 0))
+}
+
+func TestNegativeWrite(t *testing.T) {
+	program := loadProgram(t, "testdata/negative-write.txt")
+
+	computer := intcode.NewComputer(program)
+	_, expected := computer.Step()
+
+	computer = intcode.NewComputer(program)
+	err := NegativeWrite(computer)
+
+	assert.Equal(t, "negative address: -1", expected.Error())
+	assert.Equal(t, expected, err)
+	assert.Equal(t, intcode.AddressLocation(0), computer.InstructionPointer())
+}
+
+// The checked in sources have to match what the generator produces today.
+func TestGeneratedUpToDate(t *testing.T) {
+	generated := []struct {
+		source   string
+		function string
+		file     string
+	}{
+		{"../../../solutions/day-02/input.txt", "Day02", "day02.go"},
+		{"../../../solutions/day-05/input.txt", "Day05", "day05.go"},
+		{"../../../solutions/day-07/input.txt", "Day07", "day07.go"},
+		{"testdata/double-input.txt", "DoubleInput", "double_input.go"},
+		{"testdata/is-greater-then-zero.txt", "IsGreaterThenZero", "is_greater_then_zero.go"},
+		{"testdata/negative-write.txt", "NegativeWrite", "negative_write.go"},
+	}
+
+	for _, g := range generated {
+		expected, err := transpiler.Generate(loadProgram(t, g.source), transpiler.Options{
+			Package:  "programs",
+			Function: g.function,
+			Source:   g.source,
+		})
+		assert.Nil(t, err)
+
+		actual, err := ioutil.ReadFile(g.file)
+		assert.Nil(t, err)
+
+		assert.Equal(t, string(expected), string(actual), "%s is out of date, run go generate", g.file)
+	}
+}
+
+func benchmarkDay02(b *testing.B, run runner) {
+	rawProgram, err := ioutil.ReadFile("../../../solutions/day-02/input.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	program, err := intcode.ParseInput(string(rawProgram))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for i := 0; i < b.N; i++ {
+		computer := intcode.NewComputer(program)
+		computer.Memory.Set(1, 12)
+		computer.Memory.Set(2, 2)
+
+		if err := run(computer); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDay02Interpreted(b *testing.B) {
+	benchmarkDay02(b, interpret)
+}
+
+func BenchmarkDay02Native(b *testing.B) {
+	benchmarkDay02(b, Day02)
+}
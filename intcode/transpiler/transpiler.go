@@ -0,0 +1,269 @@
+package transpiler
+
+import (
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/intcode/analysis"
+)
+
+// Options controls the shape of the generated source.
+type Options struct {
+	// Package the generated file belongs to
+	Package string
+	// Name of the generated function
+	Function string
+	// Where the program came from, only used in the generated comment
+	Source string
+}
+
+// Decode every instruction reachable from the entry point plus anything a linear sweep can decode.
+//
+// Instructions the sweep finds in data are harmless, they only run if the instruction pointer
+// ever lands on them and the code check still passes.
+func collectInstructions(program []intcode.AddressValue) []analysis.Instruction {
+	instructions := make(map[intcode.AddressLocation]analysis.Instruction)
+
+	for _, block := range analysis.Analyze(program).Blocks {
+		for _, instruction := range block.Instructions {
+			instructions[instruction.Address] = instruction
+		}
+	}
+
+	for address := intcode.AddressLocation(0); int(address) < len(program); {
+		instruction, err := analysis.Decode(program, address)
+		if err != nil {
+			address++
+
+			continue
+		}
+
+		instructions[address] = instruction
+		address = instruction.Next()
+	}
+
+	sorted := make([]analysis.Instruction, 0, len(instructions))
+
+	for _, instruction := range instructions {
+		if supported(instruction) {
+			sorted = append(sorted, instruction)
+		}
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Address < sorted[j].Address
+	})
+
+	return sorted
+}
+
+// Only the instructions we know how to translate get compiled, the rest are interpreted.
+//
+// The relative base only exists inside the interpreter, so anything that uses it is interpreted too.
+func supported(instruction analysis.Instruction) bool {
+	for _, mode := range instruction.Modes {
+		if mode == intcode.Relative {
+			return false
+		}
+	}
+
+	switch instruction.Opcode.Opcode {
+	case intcode.ADD, intcode.MULTIPLY, intcode.INPUT, intcode.OUTPUT,
+		intcode.JUMPIFTRUE, intcode.JUMPIFFALSE, intcode.LESSTHAN, intcode.EQUALS, intcode.HALT:
+		return true
+	default:
+		return false
+	}
+}
+
+// Render a read parameter as a Go expression.
+func read(instruction analysis.Instruction, index int) string {
+	parameter := instruction.Parameters[index]
+
+	if instruction.Modes[index] == intcode.Immediate {
+		return fmt.Sprintf("intcode.AddressValue(%d)", parameter)
+	}
+
+	return fmt.Sprintf("mem.Get(%d)", parameter)
+}
+
+// Check the instruction in memory is still the one that was compiled.
+//
+// Arithmetic is left to the interpreter when the computer is checking for overflows, and
+// input and output when they have a timeout.
+func guard(instruction analysis.Instruction) string {
+	var checks []string
+
+	switch instruction.Opcode.Opcode {
+	case intcode.ADD, intcode.MULTIPLY:
+		checks = append(checks, "computer.OverflowDetection()")
+	case intcode.INPUT, intcode.OUTPUT:
+		checks = append(checks, "computer.IOTimeout() != 0")
+	}
+
+	checks = append(checks, fmt.Sprintf("mem.Get(%d) != %d", instruction.Address, instruction.Raw))
+
+	for i, parameter := range instruction.Parameters {
+		checks = append(checks, fmt.Sprintf("mem.Get(%d) != %d", int(instruction.Address)+i+1, parameter))
+	}
+
+	return strings.Join(checks, " || ")
+}
+
+// Write the Go statements for a single instruction.
+//
+// Control ends up either in the next instruction or back at the top of the dispatch loop.
+func body(out *strings.Builder, instruction analysis.Instruction, nextIsCompiled bool) {
+	write := func(format string, args ...interface{}) {
+		fmt.Fprintf(out, "\t\t\t"+format+"\n", args...)
+	}
+
+	// Writes can still fail, like on a negative address, so stop where the interpreter would
+	set := func(indent string, address intcode.AddressValue, value string) {
+		write(indent+"if err := mem.Set(%d, %s); err != nil {", address, value)
+		write(indent + "\tcomputer.SetInstructionPointer(ip)")
+		write("")
+		write(indent + "\treturn err")
+		write(indent + "}")
+	}
+
+	next := instruction.Next()
+
+	switch instruction.Opcode.Opcode {
+	case intcode.ADD:
+		set("", instruction.Parameters[2], read(instruction, 0)+"+"+read(instruction, 1))
+	case intcode.MULTIPLY:
+		set("", instruction.Parameters[2], read(instruction, 0)+"*"+read(instruction, 1))
+	case intcode.INPUT:
+		set("", instruction.Parameters[0], "<-computer.Input")
+	case intcode.OUTPUT:
+		write("computer.Output <- %s", read(instruction, 0))
+	case intcode.LESSTHAN, intcode.EQUALS:
+		operator := "<"
+		if instruction.Opcode.Opcode == intcode.EQUALS {
+			operator = "=="
+		}
+
+		write("if %s %s %s {", read(instruction, 0), operator, read(instruction, 1))
+		set("\t", instruction.Parameters[2], "1")
+		write("} else {")
+		set("\t", instruction.Parameters[2], "0")
+		write("}")
+	case intcode.HALT:
+		write("computer.Halt()")
+		write("")
+		write("return nil")
+
+		return
+	case intcode.JUMPIFTRUE, intcode.JUMPIFFALSE:
+		target := fmt.Sprintf("%d", instruction.Parameters[1])
+		if instruction.Modes[1] == intcode.Position {
+			target = fmt.Sprintf("intcode.AddressLocation(mem.Get(%d))", instruction.Parameters[1])
+		}
+
+		taken, known := instruction.StaticCondition()
+
+		switch {
+		case known && taken:
+			write("ip = %s", target)
+			write("")
+			write("continue")
+
+			return
+		case !known:
+			operator := "!="
+			if instruction.Opcode.Opcode == intcode.JUMPIFFALSE {
+				operator = "=="
+			}
+
+			write("if %s %s 0 {", read(instruction, 0), operator)
+			write("\tip = %s", target)
+			write("")
+			write("\tcontinue")
+			write("}")
+		}
+	}
+
+	write("")
+
+	if nextIsCompiled {
+		write("ip = %d", next)
+		write("")
+		write("fallthrough")
+
+		return
+	}
+
+	write("ip = %d", next)
+	write("")
+	write("continue")
+}
+
+// Generate Go source for a function that runs program on a computer.
+//
+// Every decoded instruction becomes straight line Go code that runs as long as memory still
+// holds the instruction that was compiled. Anything else, like code the program wrote itself
+// or an address that does not decode, runs a single step on the interpreter instead.
+func Generate(program []intcode.AddressValue, options Options) ([]byte, error) {
+	if options.Package == "" || options.Function == "" {
+		return nil, fmt.Errorf("package and function names are required")
+	}
+
+	instructions := collectInstructions(program)
+
+	var out strings.Builder
+
+	fmt.Fprintf(&out, "// Code generated by intcode-transpile. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package %s\n\n", options.Package)
+	fmt.Fprintf(&out, "import \"github.com/giodamelio/aoc-2020-go/intcode\"\n\n")
+
+	if options.Source != "" {
+		fmt.Fprintf(&out, "// %s runs the intcode program from %s natively.\n", options.Function, options.Source)
+	} else {
+		fmt.Fprintf(&out, "// %s runs an intcode program natively.\n", options.Function)
+	}
+
+	fmt.Fprintf(&out, "//\n// Code the program changes at runtime is run by the interpreter.\n")
+	fmt.Fprintf(&out, "func %s(computer *intcode.Computer) error {\n", options.Function)
+	fmt.Fprintf(&out, "\tmem := computer.Memory\n")
+	fmt.Fprintf(&out, "\tip := computer.InstructionPointer()\n")
+	fmt.Fprintf(&out, "\tcomputer.State = \"running\"\n\n")
+	fmt.Fprintf(&out, "\t// Policies, self modification tracking and custom instruction sets are only supported by the interpreter\n")
+	fmt.Fprintf(&out, "\tif computer.RequiresInterpreter() {\n")
+	fmt.Fprintf(&out, "\t\tfor {\n")
+	fmt.Fprintf(&out, "\t\t\topcode, err := computer.Step()\n")
+	fmt.Fprintf(&out, "\t\t\tif err != nil {\n\t\t\t\treturn err\n\t\t\t}\n\n")
+	fmt.Fprintf(&out, "\t\t\tif opcode == intcode.HALT {\n\t\t\t\tcomputer.Halt()\n\n\t\t\t\treturn nil\n\t\t\t}\n")
+	fmt.Fprintf(&out, "\t\t}\n")
+	fmt.Fprintf(&out, "\t}\n\n")
+	fmt.Fprintf(&out, "\tfor {\n")
+	fmt.Fprintf(&out, "\t\tswitch ip {\n")
+
+	for i, instruction := range instructions {
+		nextIsCompiled := i+1 < len(instructions) && instructions[i+1].Address == instruction.Next()
+
+		fmt.Fprintf(&out, "\t\tcase %d: // %s\n", instruction.Address, strings.ReplaceAll(instruction.String(), "\t", " "))
+		fmt.Fprintf(&out, "\t\t\tif %s {\n\t\t\t\tbreak\n\t\t\t}\n\n", guard(instruction))
+		body(&out, instruction, nextIsCompiled)
+	}
+
+	fmt.Fprintf(&out, "\t\t}\n\n")
+	fmt.Fprintf(&out, "\t\t// Fall back to the interpreter for a single instruction\n")
+	fmt.Fprintf(&out, "\t\tcomputer.SetInstructionPointer(ip)\n\n")
+	fmt.Fprintf(&out, "\t\topcode, err := computer.Step()\n")
+	fmt.Fprintf(&out, "\t\tif err != nil {\n\t\t\treturn err\n\t\t}\n\n")
+	fmt.Fprintf(&out, "\t\tif opcode == intcode.HALT {\n\t\t\tcomputer.Halt()\n\n\t\t\treturn nil\n\t\t}\n\n")
+	fmt.Fprintf(&out, "\t\tip = computer.InstructionPointer()\n")
+	fmt.Fprintf(&out, "\t}\n")
+	fmt.Fprintf(&out, "}\n")
+
+	source, err := format.Source([]byte(out.String()))
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated source: %w", err)
+	}
+
+	return source, nil
+}
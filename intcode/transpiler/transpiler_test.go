@@ -0,0 +1,159 @@
+package transpiler
+
+import (
+	"testing"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/intcode/analysis"
+	"github.com/giodamelio/aoc-2020-go/intcode/assembler"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectInstructions(t *testing.T) {
+	// The linear sweep also finds the OUTPUT hidden behind the jump
+	instructions := collectInstructions(assembler.Assemble(`
+	JUMP-IF-TRUE	i1	i5
+	OUTPUT	i1
+	HALT
+	`))
+
+	addresses := make([]intcode.AddressLocation, len(instructions))
+	for i, instruction := range instructions {
+		addresses[i] = instruction.Address
+	}
+
+	assert.Equal(t, []intcode.AddressLocation{0, 3, 5}, addresses)
+}
+
+func TestSupported(t *testing.T) {
+	instruction, err := analysis.Decode([]intcode.AddressValue{1101, 11, 22, 0}, 0)
+	assert.Nil(t, err)
+	assert.True(t, supported(instruction))
+
+	// Anything that touches the relative base is left to the interpreter
+	instruction, err = analysis.Decode([]intcode.AddressValue{109, 11}, 0)
+	assert.Nil(t, err)
+	assert.False(t, supported(instruction))
+
+	instruction, err = analysis.Decode([]intcode.AddressValue{1201, 11, 22, 0}, 0)
+	assert.Nil(t, err)
+	assert.False(t, supported(instruction))
+}
+
+func TestGuard(t *testing.T) {
+	instruction, err := analysis.Decode([]intcode.AddressValue{1101, 11, 22, 0}, 0)
+	assert.Nil(t, err)
+
+	assert.Equal(
+		t,
+		"computer.OverflowDetection() || mem.Get(0) != 1101 || mem.Get(1) != 11 || mem.Get(2) != 22 || mem.Get(3) != 0",
+		guard(instruction),
+	)
+
+	instruction, err = analysis.Decode([]intcode.AddressValue{104, 11}, 0)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "computer.IOTimeout() != 0 || mem.Get(0) != 104 || mem.Get(1) != 11", guard(instruction))
+
+	instruction, err = analysis.Decode([]intcode.AddressValue{1007, 11, 22, 0}, 0)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "mem.Get(0) != 1007 || mem.Get(1) != 11 || mem.Get(2) != 22 || mem.Get(3) != 0", guard(instruction))
+}
+
+func TestRead(t *testing.T) {
+	instruction, err := analysis.Decode([]intcode.AddressValue{1001, 11, 22, 0}, 0)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "mem.Get(11)", read(instruction, 0))
+	assert.Equal(t, "intcode.AddressValue(22)", read(instruction, 1))
+}
+
+func TestGenerate(t *testing.T) {
+	source, err := Generate(assembler.Assemble(`
+	ADD		i11	i22	0
+	HALT
+	`), Options{Package: "programs", Function: "AddTwoNumbers"})
+	assert.Nil(t, err)
+
+	assert.Equal(t, `// Code generated by intcode-transpile. DO NOT EDIT.
+
+package programs
+
+import "github.com/giodamelio/aoc-2020-go/intcode"
+
+// AddTwoNumbers runs an intcode program natively.
+//
+// Code the program changes at runtime is run by the interpreter.
+func AddTwoNumbers(computer *intcode.Computer) error {
+	mem := computer.Memory
+	ip := computer.InstructionPointer()
+	computer.State = "running"
+
+	// Policies, self modification tracking and custom instruction sets are only supported by the interpreter
+	if computer.RequiresInterpreter() {
+		for {
+			opcode, err := computer.Step()
+			if err != nil {
+				return err
+			}
+
+			if opcode == intcode.HALT {
+				computer.Halt()
+
+				return nil
+			}
+		}
+	}
+
+	for {
+		switch ip {
+		case 0: // ADD i11 i22 0
+			if computer.OverflowDetection() || mem.Get(0) != 1101 || mem.Get(1) != 11 || mem.Get(2) != 22 || mem.Get(3) != 0 {
+				break
+			}
+
+			if err := mem.Set(0, intcode.AddressValue(11)+intcode.AddressValue(22)); err != nil {
+				computer.SetInstructionPointer(ip)
+
+				return err
+			}
+
+			ip = 4
+
+			fallthrough
+		case 4: // HALT
+			if mem.Get(4) != 99 {
+				break
+			}
+
+			computer.Halt()
+
+			return nil
+		}
+
+		// Fall back to the interpreter for a single instruction
+		computer.SetInstructionPointer(ip)
+
+		opcode, err := computer.Step()
+		if err != nil {
+			return err
+		}
+
+		if opcode == intcode.HALT {
+			computer.Halt()
+
+			return nil
+		}
+
+		ip = computer.InstructionPointer()
+	}
+}
+`, string(source))
+}
+
+func TestGenerateRequiresNames(t *testing.T) {
+	_, err := Generate([]intcode.AddressValue{99}, Options{Package: "programs"})
+
+	assert.Equal(t, "package and function names are required", err.Error())
+}
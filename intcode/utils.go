@@ -1,8 +1,9 @@
 package intcode
 
 import (
-	"strconv"
-	"strings"
+	"math"
+
+	"github.com/giodamelio/aoc-2020-go/parse"
 )
 
 func copyMemory(input []AddressValue) []AddressValue {
@@ -12,18 +13,38 @@ func copyMemory(input []AddressValue) []AddressValue {
 	return inputCopy
 }
 
+// ParseInput reads a comma separated program.
 func ParseInput(input string) ([]AddressValue, error) {
-	bytes := strings.Split(strings.TrimSpace(input), ",")
-	numbers := make([]AddressValue, len(bytes))
-
-	for index, programByte := range bytes {
-		number, err := strconv.Atoi(programByte)
-		if err != nil {
-			return nil, err
-		}
+	values, err := parse.Int64s(input, ",")
+	if err != nil {
+		return nil, err
+	}
 
-		numbers[index] = AddressValue(number)
+	numbers := make([]AddressValue, len(values))
+	for index, value := range values {
+		numbers[index] = AddressValue(value)
 	}
 
 	return numbers, nil
 }
+
+// Check if adding two values would wrap around.
+func addOverflows(lhs AddressValue, rhs AddressValue) bool {
+	result := lhs + rhs
+
+	return (lhs > 0 && rhs > 0 && result < 0) || (lhs < 0 && rhs < 0 && result >= 0)
+}
+
+// Check if multiplying two values would wrap around.
+func multiplyOverflows(lhs AddressValue, rhs AddressValue) bool {
+	if lhs == 0 || rhs == 0 {
+		return false
+	}
+
+	// The one case where dividing the result back out can't catch it
+	if (lhs == -1 && rhs == math.MinInt64) || (rhs == -1 && lhs == math.MinInt64) {
+		return true
+	}
+
+	return (lhs*rhs)/rhs != lhs
+}
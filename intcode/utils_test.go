@@ -1,6 +1,7 @@
 package intcode
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -27,6 +28,23 @@ func TestParseInputNonInteger(t *testing.T) {
 	parsedInput, err := ParseInput("1,haha,3")
 
 	assert.Error(t, err)
-	assert.Equal(t, err.Error(), "strconv.Atoi: parsing \"haha\": invalid syntax")
+	assert.Equal(t, err.Error(), "line 1, field 2: \"haha\" is not an integer: invalid syntax")
 	assert.Nil(t, parsedInput)
 }
+
+func TestAddOverflows(t *testing.T) {
+	assert.False(t, addOverflows(1, 2))
+	assert.False(t, addOverflows(math.MaxInt64, math.MinInt64))
+	assert.True(t, addOverflows(math.MaxInt64, 1))
+	assert.True(t, addOverflows(math.MinInt64, -1))
+}
+
+func TestMultiplyOverflows(t *testing.T) {
+	assert.False(t, multiplyOverflows(0, math.MaxInt64))
+	assert.False(t, multiplyOverflows(34915192, 34915192))
+	assert.False(t, multiplyOverflows(-1, math.MaxInt64))
+	assert.True(t, multiplyOverflows(math.MaxInt64, 2))
+	assert.True(t, multiplyOverflows(-1, math.MinInt64))
+	assert.True(t, multiplyOverflows(math.MinInt64, -1))
+	assert.True(t, multiplyOverflows(1<<32, 1<<32))
+}
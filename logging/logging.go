@@ -0,0 +1,225 @@
+// Package logging sets up the loggers shared by the solutions and the intcode computer.
+//
+// Levels are written like "info,memory=trace,opcode=debug", the bare level applies to
+// everything and each subsystem can be turned up or down on its own.
+package logging
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+// The subsystems that can have their own level.
+const (
+	Computer  = "computer"
+	Memory    = "memory"
+	Opcode    = "opcode"
+	Scheduler = "scheduler"
+	Pipeline  = "pipeline"
+	Network   = "network"
+)
+
+// The formats logs can be written in.
+const (
+	ConsoleFormat = "console"
+	JSONFormat    = "json"
+)
+
+// Environment variables that set the defaults for the flags.
+const (
+	LevelEnv  = "AOC_LOG_LEVEL"
+	FormatEnv = "AOC_LOG_FORMAT"
+)
+
+// Config is how logs should be written.
+type Config struct {
+	Level zerolog.Level
+	// Levels for subsystems that differ from Level
+	Subsystems map[string]zerolog.Level
+	Format     string
+	NoColor    bool
+}
+
+// DefaultConfig logs at level to the console, unless the environment says otherwise.
+func DefaultConfig(level zerolog.Level) (Config, error) {
+	config := Config{Level: level, Format: ConsoleFormat}
+
+	if levels, ok := os.LookupEnv(LevelEnv); ok {
+		if err := config.SetLevels(levels); err != nil {
+			return config, fmt.Errorf("%s: %w", LevelEnv, err)
+		}
+	}
+
+	if format, ok := os.LookupEnv(FormatEnv); ok {
+		if err := config.SetFormat(format); err != nil {
+			return config, fmt.Errorf("%s: %w", FormatEnv, err)
+		}
+	}
+
+	return config, nil
+}
+
+// SetLevels parses levels like "warn,memory=trace".
+func (c *Config) SetLevels(levels string) error {
+	subsystems := make(map[string]zerolog.Level)
+	level := c.Level
+
+	for _, entry := range strings.Split(levels, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name := ""
+		if parts := strings.SplitN(entry, "=", 2); len(parts) == 2 {
+			name = strings.ToLower(strings.TrimSpace(parts[0]))
+			entry = strings.TrimSpace(parts[1])
+		}
+
+		parsed, err := zerolog.ParseLevel(strings.ToLower(entry))
+		if err != nil || entry == "" {
+			return fmt.Errorf("unknown log level: %q", entry)
+		}
+
+		if name == "" {
+			level = parsed
+		} else {
+			subsystems[name] = parsed
+		}
+	}
+
+	c.Level = level
+	c.Subsystems = subsystems
+
+	return nil
+}
+
+// Levels formats the levels the same way SetLevels reads them.
+func (c Config) Levels() string {
+	entries := []string{c.Level.String()}
+
+	for name, level := range c.Subsystems {
+		entries = append(entries, name+"="+level.String())
+	}
+
+	sort.Strings(entries[1:])
+
+	return strings.Join(entries, ",")
+}
+
+// SetFormat picks between console and json output.
+func (c *Config) SetFormat(format string) error {
+	switch format {
+	case ConsoleFormat, JSONFormat:
+		c.Format = format
+
+		return nil
+	default:
+		return fmt.Errorf("unknown log format: %q, expected %s or %s", format, ConsoleFormat, JSONFormat)
+	}
+}
+
+type levelsFlag struct{ config *Config }
+
+func (f levelsFlag) String() string {
+	if f.config == nil {
+		return ""
+	}
+
+	return f.config.Levels()
+}
+
+func (f levelsFlag) Set(value string) error {
+	return f.config.SetLevels(value)
+}
+
+type formatFlag struct{ config *Config }
+
+func (f formatFlag) String() string {
+	if f.config == nil {
+		return ""
+	}
+
+	return f.config.Format
+}
+
+func (f formatFlag) Set(value string) error {
+	return f.config.SetFormat(value)
+}
+
+// RegisterFlags adds -log-level and -log-format, the current config is used as the default.
+func (c *Config) RegisterFlags(flags *flag.FlagSet) {
+	flags.Var(levelsFlag{c}, "log-level", fmt.Sprintf(
+		"log `levels` like warn,memory=trace (subsystems: %s), also read from $%s",
+		strings.Join([]string{Computer, Memory, Opcode, Scheduler, Pipeline, Network}, ", "),
+		LevelEnv,
+	))
+	flags.Var(formatFlag{c}, "log-format", fmt.Sprintf(
+		"`format` of the logs, %s or %s, also read from $%s",
+		ConsoleFormat,
+		JSONFormat,
+		FormatEnv,
+	))
+}
+
+// New creates the root logger writing to out and sets the subsystem levels.
+func (c Config) New(out io.Writer) zerolog.Logger {
+	if c.Format == ConsoleFormat {
+		console := zerolog.NewConsoleWriter()
+		console.Out = out
+		console.NoColor = c.NoColor
+		out = console
+	}
+
+	// Levels are handled by each logger, so nothing is filtered globally
+	zerolog.SetGlobalLevel(zerolog.TraceLevel)
+	SetSubsystemLevels(c.Subsystems)
+
+	return zerolog.New(out).With().Timestamp().Logger().Level(c.Level)
+}
+
+var (
+	subsystemLevelsLock sync.RWMutex
+	subsystemLevels     = map[string]zerolog.Level{}
+)
+
+// SetSubsystemLevels replaces the levels used by Subsystem.
+func SetSubsystemLevels(levels map[string]zerolog.Level) {
+	copied := make(map[string]zerolog.Level, len(levels))
+	for name, level := range levels {
+		copied[name] = level
+	}
+
+	subsystemLevelsLock.Lock()
+	defer subsystemLevelsLock.Unlock()
+
+	subsystemLevels = copied
+}
+
+// Subsystem creates the logger for a part of the program from its parent.
+//
+// A disabled parent stays disabled, so turning a subsystem up never makes a quiet logger noisy.
+func Subsystem(logger zerolog.Logger, name string) zerolog.Logger {
+	if logger.GetLevel() == zerolog.Disabled {
+		return logger
+	}
+
+	logger = logger.With().Str("subsystem", name).Logger()
+
+	subsystemLevelsLock.RLock()
+	level, ok := subsystemLevels[name]
+	subsystemLevelsLock.RUnlock()
+
+	if ok {
+		logger = logger.Level(level)
+	}
+
+	return logger
+}
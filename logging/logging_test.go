@@ -0,0 +1,112 @@
+package logging
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetLevels(t *testing.T) {
+	config := Config{Level: zerolog.WarnLevel}
+
+	assert.Nil(t, config.SetLevels("debug, Memory=trace,opcode=ERROR"))
+	assert.Equal(t, zerolog.DebugLevel, config.Level)
+	assert.Equal(t, map[string]zerolog.Level{
+		Memory: zerolog.TraceLevel,
+		Opcode: zerolog.ErrorLevel,
+	}, config.Subsystems)
+	assert.Equal(t, "debug,memory=trace,opcode=error", config.Levels())
+
+	// Only setting subsystems keeps the current level
+	assert.Nil(t, config.SetLevels("computer=info"))
+	assert.Equal(t, zerolog.DebugLevel, config.Level)
+	assert.Equal(t, "debug,computer=info", config.Levels())
+
+	assert.Equal(t, `unknown log level: "loud"`, config.SetLevels("loud").Error())
+	assert.Equal(t, `unknown log level: ""`, config.SetLevels("memory=").Error())
+}
+
+func TestSetFormat(t *testing.T) {
+	config := Config{Format: ConsoleFormat}
+
+	assert.Nil(t, config.SetFormat(JSONFormat))
+	assert.Equal(t, JSONFormat, config.Format)
+
+	assert.Equal(t, `unknown log format: "xml", expected console or json`, config.SetFormat("xml").Error())
+}
+
+func TestDefaultConfig(t *testing.T) {
+	config, err := DefaultConfig(zerolog.WarnLevel)
+	assert.Nil(t, err)
+	assert.Equal(t, Config{Level: zerolog.WarnLevel, Format: ConsoleFormat}, config)
+
+	os.Setenv(LevelEnv, "info,memory=trace")
+	os.Setenv(FormatEnv, "json")
+
+	defer os.Unsetenv(LevelEnv)
+	defer os.Unsetenv(FormatEnv)
+
+	config, err = DefaultConfig(zerolog.WarnLevel)
+	assert.Nil(t, err)
+	assert.Equal(t, "info,memory=trace", config.Levels())
+	assert.Equal(t, JSONFormat, config.Format)
+
+	os.Setenv(LevelEnv, "loud")
+
+	_, err = DefaultConfig(zerolog.WarnLevel)
+	assert.Equal(t, `AOC_LOG_LEVEL: unknown log level: "loud"`, err.Error())
+}
+
+func TestRegisterFlags(t *testing.T) {
+	config := Config{Level: zerolog.WarnLevel, Format: ConsoleFormat}
+
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	config.RegisterFlags(flags)
+
+	assert.Equal(t, "warn", flags.Lookup("log-level").DefValue)
+	assert.Equal(t, "console", flags.Lookup("log-format").DefValue)
+
+	assert.Nil(t, flags.Parse([]string{"-log-level", "trace,opcode=info", "-log-format", "json"}))
+	assert.Equal(t, "trace,opcode=info", config.Levels())
+	assert.Equal(t, JSONFormat, config.Format)
+}
+
+func TestNew(t *testing.T) {
+	defer SetSubsystemLevels(nil)
+
+	config := Config{
+		Level:      zerolog.InfoLevel,
+		Subsystems: map[string]zerolog.Level{Memory: zerolog.TraceLevel},
+		Format:     JSONFormat,
+	}
+
+	var out bytes.Buffer
+
+	logger := config.New(&out)
+	logger.Debug().Msg("hidden")
+	logger.Info().Msg("shown")
+
+	memory := Subsystem(logger, Memory)
+	memory.Trace().Msg("memory")
+
+	opcode := Subsystem(logger, Opcode)
+	opcode.Debug().Msg("opcode")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"message":"shown"`)
+	assert.Contains(t, lines[1], `"subsystem":"memory"`)
+	assert.Contains(t, lines[1], `"message":"memory"`)
+}
+
+func TestSubsystemDisabled(t *testing.T) {
+	SetSubsystemLevels(map[string]zerolog.Level{Memory: zerolog.TraceLevel})
+	defer SetSubsystemLevels(nil)
+
+	assert.Equal(t, zerolog.Disabled, Subsystem(zerolog.Nop(), Memory).GetLevel())
+}
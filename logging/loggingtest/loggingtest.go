@@ -0,0 +1,35 @@
+// Package loggingtest gives tests a logger that only shows up when they fail or run with -v.
+package loggingtest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+type testWriter struct {
+	t testing.TB
+}
+
+func (w testWriter) Write(p []byte) (int, error) {
+	w.t.Helper()
+	w.t.Log(strings.TrimRight(string(p), "\n"))
+
+	return len(p), nil
+}
+
+// New creates a logger that writes everything to the test's log.
+//
+// Nothing may log through it once the test has finished.
+func New(t testing.TB) zerolog.Logger {
+	out := zerolog.NewConsoleWriter()
+	out.Out = testWriter{t}
+	out.NoColor = true
+	out.PartsExclude = []string{zerolog.TimestampFieldName}
+
+	// The computers log at trace, which is below the global default
+	zerolog.SetGlobalLevel(zerolog.TraceLevel)
+
+	return zerolog.New(out).Level(zerolog.TraceLevel)
+}
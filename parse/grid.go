@@ -0,0 +1,69 @@
+package parse
+
+// Grid is a rectangle of characters, indexed by row and then column.
+type Grid [][]byte
+
+// ParseGrid reads a grid of characters, every row has to be the same width.
+func ParseGrid(input string) (Grid, error) {
+	lines := Lines(input)
+	grid := make(Grid, len(lines))
+
+	for index, line := range lines {
+		if index > 0 && len(line) != len(lines[0]) {
+			return nil, lineError(index+1, "row is %d wide, expected %d", len(line), len(lines[0]))
+		}
+
+		grid[index] = []byte(line)
+	}
+
+	return grid, nil
+}
+
+// Width is the number of columns.
+func (g Grid) Width() int {
+	if len(g) == 0 {
+		return 0
+	}
+
+	return len(g[0])
+}
+
+// Height is the number of rows.
+func (g Grid) Height() int {
+	return len(g)
+}
+
+// At gets the character at a column and row, false if it is outside the grid.
+func (g Grid) At(x int, y int) (byte, bool) {
+	if y < 0 || y >= g.Height() || x < 0 || x >= g.Width() {
+		return 0, false
+	}
+
+	return g[y][x], true
+}
+
+// Find every position of a character.
+func (g Grid) Find(character byte) [][2]int {
+	var positions [][2]int
+
+	for y, row := range g {
+		for x, current := range row {
+			if current == character {
+				positions = append(positions, [2]int{x, y})
+			}
+		}
+	}
+
+	return positions
+}
+
+func (g Grid) String() string {
+	var out []byte
+
+	for _, row := range g {
+		out = append(out, row...)
+		out = append(out, '\n')
+	}
+
+	return string(out)
+}
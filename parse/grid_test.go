@@ -0,0 +1,40 @@
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGrid(t *testing.T) {
+	grid, err := ParseGrid(".#.\n#..\n")
+	assert.Nil(t, err)
+
+	assert.Equal(t, 3, grid.Width())
+	assert.Equal(t, 2, grid.Height())
+
+	character, ok := grid.At(1, 0)
+	assert.True(t, ok)
+	assert.Equal(t, byte('#'), character)
+
+	_, ok = grid.At(3, 0)
+	assert.False(t, ok)
+
+	_, ok = grid.At(0, -1)
+	assert.False(t, ok)
+
+	assert.Equal(t, [][2]int{{1, 0}, {0, 1}}, grid.Find('#'))
+	assert.Equal(t, ".#.\n#..\n", grid.String())
+}
+
+func TestParseGridRagged(t *testing.T) {
+	_, err := ParseGrid("...\n..\n")
+	assert.Equal(t, "line 2: row is 2 wide, expected 3", err.Error())
+}
+
+func TestEmptyGrid(t *testing.T) {
+	grid, err := ParseGrid("")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, grid.Width())
+	assert.Equal(t, 0, grid.Height())
+}
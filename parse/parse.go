@@ -0,0 +1,184 @@
+// Package parse turns puzzle inputs into the shapes the solutions need.
+//
+// Every error says which line of the input it came from.
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Error is a problem with a single line of the input, lines start at 1.
+type Error struct {
+	Line int
+	// Which of the separated values it was, starting at 1, or 0 when the line is enough
+	Field int
+	Err   error
+}
+
+func (e *Error) Error() string {
+	if e.Field > 0 {
+		return fmt.Sprintf("line %d, field %d: %s", e.Line, e.Field, e.Err)
+	}
+
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func lineError(line int, format string, args ...interface{}) error {
+	return &Error{Line: line, Err: fmt.Errorf(format, args...)}
+}
+
+// Lines splits the input into lines, ignoring the trailing newline.
+func Lines(input string) []string {
+	input = strings.TrimRight(input, "\n")
+	if input == "" {
+		return nil
+	}
+
+	return strings.Split(input, "\n")
+}
+
+// A piece of the input along with the line it started on.
+type field struct {
+	line int
+	// Position among all the fields, starting at 1, or 0 if every field is on its own line
+	index int
+	text  string
+}
+
+const whitespace = " \t\r\n"
+
+// Split the trimmed input on a separator, keeping track of the line each field starts on.
+func fields(input string, separator string) []field {
+	trimmed := strings.TrimSpace(input)
+	if trimmed == "" {
+		return nil
+	}
+
+	// Count the lines trimmed off the front so the line numbers still match the input
+	line := 1 + strings.Count(input[:len(input)-len(strings.TrimLeft(input, whitespace))], "\n")
+
+	parts := strings.Split(trimmed, separator)
+	result := make([]field, len(parts))
+
+	// The line is enough to find a field unless a line can hold more than one, like "1,2,3"
+	numbered := !strings.Contains(separator, "\n")
+
+	for index, part := range parts {
+		leading := len(part) - len(strings.TrimLeft(part, whitespace))
+
+		result[index] = field{
+			line: line + strings.Count(part[:leading], "\n"),
+			text: strings.TrimSpace(part),
+		}
+
+		if numbered {
+			result[index].index = index + 1
+		}
+
+		line += strings.Count(part, "\n") + strings.Count(separator, "\n")
+	}
+
+	return result
+}
+
+func parseInt(f field, bitSize int) (int64, error) {
+	number, err := strconv.ParseInt(f.text, 10, bitSize)
+	if err != nil {
+		return 0, &Error{Line: f.line, Field: f.index, Err: fmt.Errorf("%q is not an integer: %w", f.text, numberError(err))}
+	}
+
+	return number, nil
+}
+
+// Ints parses integers split by a separator, like "\n" or ",".
+//
+// Space around each integer is ignored so "1, 2,\n3" works with ",".
+func Ints(input string, separator string) ([]int, error) {
+	split := fields(input, separator)
+	numbers := make([]int, len(split))
+
+	for index, f := range split {
+		number, err := parseInt(f, strconv.IntSize)
+		if err != nil {
+			return nil, err
+		}
+
+		numbers[index] = int(number)
+	}
+
+	return numbers, nil
+}
+
+// Int64s is Ints for values that need all 64 bits.
+func Int64s(input string, separator string) ([]int64, error) {
+	split := fields(input, separator)
+	numbers := make([]int64, len(split))
+
+	for index, f := range split {
+		number, err := parseInt(f, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		numbers[index] = number
+	}
+
+	return numbers, nil
+}
+
+// Records splits every line into fields by a separator, like comma separated values.
+func Records(input string, separator string) [][]string {
+	lines := Lines(input)
+	records := make([][]string, len(lines))
+
+	for index, line := range lines {
+		records[index] = strings.Split(line, separator)
+	}
+
+	return records
+}
+
+// Blocks splits the input into groups of lines separated by blank lines.
+func Blocks(input string) []string {
+	var blocks []string
+
+	for _, block := range strings.Split(strings.TrimSpace(input), "\n\n") {
+		block = strings.Trim(block, "\n")
+		if block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+
+	return blocks
+}
+
+// KeyValues parses lines like "name: value" into a map, blank lines are skipped.
+func KeyValues(input string, separator string) (map[string]string, error) {
+	values := make(map[string]string)
+
+	for index, line := range Lines(input) {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		parts := strings.SplitN(line, separator, 2)
+		if len(parts) != 2 {
+			return nil, lineError(index+1, "missing %q between key and value: %q", separator, line)
+		}
+
+		key := strings.TrimSpace(parts[0])
+		if _, ok := values[key]; ok {
+			return nil, lineError(index+1, "duplicate key: %s", key)
+		}
+
+		values[key] = strings.TrimSpace(parts[1])
+	}
+
+	return values, nil
+}
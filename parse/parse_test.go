@@ -0,0 +1,82 @@
+package parse
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLines(t *testing.T) {
+	assert.Equal(t, []string{"a", "", "b"}, Lines("a\n\nb\n"))
+	assert.Equal(t, []string{"a"}, Lines("a"))
+	assert.Nil(t, Lines(""))
+	assert.Nil(t, Lines("\n"))
+}
+
+func TestInts(t *testing.T) {
+	numbers, err := Ints("12\n14\n-1969\n", "\n")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{12, 14, -1969}, numbers)
+
+	numbers, err = Ints("1, 2,\n3\n", ",")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2, 3}, numbers)
+
+	numbers, err = Ints("\n", "\n")
+	assert.Nil(t, err)
+	assert.Empty(t, numbers)
+}
+
+func TestIntsErrors(t *testing.T) {
+	_, err := Ints("1\n2\nhaha\n", "\n")
+	assert.Equal(t, `line 3: "haha" is not an integer: invalid syntax`, err.Error())
+
+	// Leading blank lines still count
+	_, err = Ints("\n\n1\nhaha", "\n")
+	assert.Equal(t, `line 4: "haha" is not an integer: invalid syntax`, err.Error())
+
+	// Fields are counted across lines
+	_, err = Ints("1,2,\n3,haha", ",")
+	assert.Equal(t, `line 2, field 4: "haha" is not an integer: invalid syntax`, err.Error())
+
+	_, err = Ints("1,,3", ",")
+	assert.Equal(t, `line 1, field 2: "" is not an integer: invalid syntax`, err.Error())
+
+	var parseErr *Error
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 1, parseErr.Line)
+	assert.Equal(t, 2, parseErr.Field)
+}
+
+func TestInt64s(t *testing.T) {
+	numbers, err := Int64s("1,9223372036854775807", ",")
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 9223372036854775807}, numbers)
+
+	_, err = Int64s("9223372036854775808", ",")
+	assert.Equal(t, `line 1, field 1: "9223372036854775808" is not an integer: value out of range`, err.Error())
+	assert.True(t, errors.Is(err, strconv.ErrRange))
+}
+
+func TestRecords(t *testing.T) {
+	assert.Equal(t, [][]string{{"R8", "U5"}, {"U7", "R6", "D4"}}, Records("R8,U5\nU7,R6,D4\n", ","))
+}
+
+func TestBlocks(t *testing.T) {
+	assert.Equal(t, []string{"a\nb", "c", "d\ne"}, Blocks("a\nb\n\nc\n\n\n\nd\ne\n"))
+	assert.Nil(t, Blocks("\n\n"))
+}
+
+func TestKeyValues(t *testing.T) {
+	values, err := KeyValues("name: day 1\n\nsize:  10\n", ":")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"name": "day 1", "size": "10"}, values)
+
+	_, err = KeyValues("name: a\nsize 10\n", ":")
+	assert.Equal(t, `line 2: missing ":" between key and value: "size 10"`, err.Error())
+
+	_, err = KeyValues("name: a\nname: b\n", ":")
+	assert.Equal(t, "line 2: duplicate key: name", err.Error())
+}
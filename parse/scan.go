@@ -0,0 +1,140 @@
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Scan matches every line against a pattern and appends a struct filled from its named groups
+// to the slice out points to.
+//
+// Groups are matched to fields by a `parse:"name"` tag, or else by the field name ignoring case.
+// String, integer, float and bool fields are supported.
+func Scan(input string, pattern *regexp.Regexp, out interface{}) error {
+	slice := reflect.ValueOf(out)
+	if slice.Kind() != reflect.Ptr || slice.Elem().Kind() != reflect.Slice ||
+		slice.Elem().Type().Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("scan needs a pointer to a slice of structs, got %T", out)
+	}
+
+	slice = slice.Elem()
+
+	for index, line := range Lines(input) {
+		record := reflect.New(slice.Type().Elem()).Elem()
+
+		if err := scanLine(line, pattern, record); err != nil {
+			return &Error{Line: index + 1, Err: err}
+		}
+
+		slice.Set(reflect.Append(slice, record))
+	}
+
+	return nil
+}
+
+// ScanLine fills the struct out points to from the named groups of a pattern matching line.
+func ScanLine(line string, pattern *regexp.Regexp, out interface{}) error {
+	record := reflect.ValueOf(out)
+	if record.Kind() != reflect.Ptr || record.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("scan needs a pointer to a struct, got %T", out)
+	}
+
+	return scanLine(line, pattern, record.Elem())
+}
+
+func scanLine(line string, pattern *regexp.Regexp, record reflect.Value) error {
+	match := pattern.FindStringSubmatchIndex(line)
+	if match == nil || match[0] != 0 || match[1] != len(line) {
+		return fmt.Errorf("%q does not match %s", line, pattern)
+	}
+
+	for group, name := range pattern.SubexpNames() {
+		if name == "" || match[2*group] < 0 {
+			continue
+		}
+
+		field, ok := fieldFor(record, name)
+		if !ok {
+			return fmt.Errorf("%s has no field for group %s", record.Type(), name)
+		}
+
+		if err := set(field, line[match[2*group]:match[2*group+1]]); err != nil {
+			return fmt.Errorf("group %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// Find the field a group fills in.
+func fieldFor(record reflect.Value, name string) (reflect.Value, bool) {
+	recordType := record.Type()
+
+	for i := 0; i < recordType.NumField(); i++ {
+		if recordType.Field(i).Tag.Get("parse") == name {
+			return record.Field(i), true
+		}
+	}
+
+	for i := 0; i < recordType.NumField(); i++ {
+		if recordType.Field(i).PkgPath == "" && strings.EqualFold(recordType.Field(i).Name, name) {
+			return record.Field(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// Convert text to the type of a field and set it.
+func set(field reflect.Value, text string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(text)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value, err := strconv.ParseInt(text, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%q is not an integer: %w", text, numberError(err))
+		}
+
+		field.SetInt(value)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value, err := strconv.ParseUint(text, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%q is not an unsigned integer: %w", text, numberError(err))
+		}
+
+		field.SetUint(value)
+	case reflect.Float32, reflect.Float64:
+		value, err := strconv.ParseFloat(text, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%q is not a number: %w", text, numberError(err))
+		}
+
+		field.SetFloat(value)
+	case reflect.Bool:
+		value, err := strconv.ParseBool(text)
+		if err != nil {
+			return fmt.Errorf("%q is not a bool", text)
+		}
+
+		field.SetBool(value)
+	default:
+		return fmt.Errorf("cannot scan into a %s", field.Type())
+	}
+
+	return nil
+}
+
+// Drop the strconv function name from an error.
+func numberError(err error) error {
+	var numErr *strconv.NumError
+	if errors.As(err, &numErr) {
+		return numErr.Err
+	}
+
+	return err
+}
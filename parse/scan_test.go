@@ -0,0 +1,73 @@
+package parse
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type claim struct {
+	ID     int
+	Left   int `parse:"x"`
+	Top    int `parse:"y"`
+	Owner  string
+	Active bool
+	Ratio  float64
+	Size   uint8
+}
+
+var claimPattern = regexp.MustCompile(
+	`#(?P<id>\d+) (?P<owner>\w+) @ (?P<x>-?\d+),(?P<y>-?\d+)(?: (?P<active>\w+) (?P<ratio>[\d.]+) (?P<size>\d+))?`,
+)
+
+func TestScan(t *testing.T) {
+	var claims []claim
+
+	err := Scan("#1 alice @ 1,3 true 0.5 10\n#2 bob @ -3,1\n", claimPattern, &claims)
+	assert.Nil(t, err)
+	assert.Equal(t, []claim{
+		{ID: 1, Owner: "alice", Left: 1, Top: 3, Active: true, Ratio: 0.5, Size: 10},
+		{ID: 2, Owner: "bob", Left: -3, Top: 1},
+	}, claims)
+}
+
+func TestScanErrors(t *testing.T) {
+	var claims []claim
+
+	err := Scan("#1 alice @ 1,3\n#2 bob @ 1\n", claimPattern, &claims)
+	assert.Equal(t, `line 2: "#2 bob @ 1" does not match `+claimPattern.String(), err.Error())
+
+	// The whole line has to match
+	err = Scan("#1 alice @ 1,3 and more", claimPattern, &claims)
+	assert.Contains(t, err.Error(), "does not match")
+
+	err = Scan("#1 alice @ 1,3 maybe 0.5 10", claimPattern, &claims)
+	assert.Equal(t, `line 1: group active: "maybe" is not a bool`, err.Error())
+
+	err = Scan("#1 alice @ 1,3 true 0.5 300", claimPattern, &claims)
+	assert.Equal(t, `line 1: group size: "300" is not an unsigned integer: value out of range`, err.Error())
+
+	err = Scan("#1 alice @ 1,3", claimPattern, claims)
+	assert.Equal(t, "scan needs a pointer to a slice of structs, got []parse.claim", err.Error())
+
+	var names []struct{ Name string }
+
+	err = Scan("#1 alice @ 1,3", claimPattern, &names)
+	assert.Equal(t, "line 1: struct { Name string } has no field for group id", err.Error())
+}
+
+func TestScanLine(t *testing.T) {
+	var parsed claim
+
+	assert.Nil(t, ScanLine("#7 carol @ 5,6", claimPattern, &parsed))
+	assert.Equal(t, claim{ID: 7, Owner: "carol", Left: 5, Top: 6}, parsed)
+
+	err := ScanLine("#7 carol @ 5,6", claimPattern, parsed)
+	assert.Equal(t, "scan needs a pointer to a struct, got parse.claim", err.Error())
+
+	var bad struct{ ID []int }
+
+	err = ScanLine("#7 carol @ 5,6", regexp.MustCompile(`#(?P<id>\d+).*`), &bad)
+	assert.Equal(t, "group id: cannot scan into a []int", err.Error())
+}
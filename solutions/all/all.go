@@ -0,0 +1,11 @@
+// Package all imports every day so they register themselves.
+package all
+
+import (
+	// Every solved day
+	_ "github.com/giodamelio/aoc-2020-go/solutions/day-01"
+	_ "github.com/giodamelio/aoc-2020-go/solutions/day-02"
+	_ "github.com/giodamelio/aoc-2020-go/solutions/day-05"
+	_ "github.com/giodamelio/aoc-2020-go/solutions/day-07"
+	_ "github.com/giodamelio/aoc-2020-go/solutions/day-09"
+)
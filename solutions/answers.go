@@ -0,0 +1,134 @@
+package solutions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Status is how an answer compares to the known answer.
+type Status string
+
+const (
+	Pass    Status = "pass"
+	Fail    Status = "fail"
+	Unknown Status = "unknown"
+)
+
+// Answers are the known correct answers, by day and then part.
+type Answers map[int]map[int]Answer
+
+// SolutionsDirEnv is the environment variable naming the solutions directory, for running outside of the repository.
+const SolutionsDirEnv = "AOC_SOLUTIONS_DIR"
+
+// The module line that marks the root of the repository.
+const moduleLine = "module github.com/giodamelio/aoc-2020-go"
+
+// Dir finds the directory the solutions are checked in to.
+//
+// It uses $AOC_SOLUTIONS_DIR if it is set, otherwise it looks for the repository's go.mod in the
+// working directory and every directory above it.
+func Dir() (string, error) {
+	if dir := os.Getenv(SolutionsDirEnv); dir != "" {
+		return dir, nil
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	return findDir(wd)
+}
+
+func findDir(start string) (string, error) {
+	for dir := start; ; dir = filepath.Dir(dir) {
+		raw, err := ioutil.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil && isRepository(string(raw)) {
+			return filepath.Join(dir, "solutions"), nil
+		}
+
+		if filepath.Dir(dir) == dir {
+			return "", fmt.Errorf("no solutions directory in or above %s, set %s to use one from elsewhere", start, SolutionsDirEnv)
+		}
+	}
+}
+
+// Check a go.mod belongs to this repository rather than some other module.
+func isRepository(goMod string) bool {
+	for _, line := range strings.Split(goMod, "\n") {
+		if strings.TrimSpace(line) == moduleLine {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AnswersPath is the answers file checked in next to the solutions in dir.
+func AnswersPath(dir string) string {
+	return filepath.Join(dir, "answers.json")
+}
+
+// LoadAnswers reads an answers file, a missing file has no known answers.
+func LoadAnswers(path string) (Answers, error) {
+	raw, err := ioutil.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Answers{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	answers := Answers{}
+	if err := json.Unmarshal(raw, &answers); err != nil {
+		return nil, fmt.Errorf("reading answers from %s: %w", path, err)
+	}
+
+	return answers, nil
+}
+
+// Save the answers to a file.
+func (a Answers) Save(path string) error {
+	raw, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append(raw, '\n'), 0o644)
+}
+
+// Get the known answer to a part.
+func (a Answers) Get(day int, part int) (Answer, bool) {
+	answer, ok := a[day][part]
+
+	return answer, ok
+}
+
+// Set the known answer to a part.
+func (a Answers) Set(day int, part int, answer Answer) {
+	if a[day] == nil {
+		a[day] = make(map[int]Answer)
+	}
+
+	a[day][part] = answer
+}
+
+// Verify an answer against the known answer.
+func (a Answers) Verify(day int, part int, answer Answer) Status {
+	known, ok := a.Get(day, part)
+
+	switch {
+	case !ok:
+		return Unknown
+	case known == answer:
+		return Pass
+	default:
+		return Fail
+	}
+}
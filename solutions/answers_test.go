@@ -0,0 +1,101 @@
+package solutions
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnswers(t *testing.T) {
+	answers := Answers{}
+
+	_, ok := answers.Get(1, 1)
+	assert.False(t, ok)
+	assert.Equal(t, Unknown, answers.Verify(1, 1, "10"))
+
+	answers.Set(1, 1, "10")
+
+	assert.Equal(t, Pass, answers.Verify(1, 1, "10"))
+	assert.Equal(t, Fail, answers.Verify(1, 1, "11"))
+	assert.Equal(t, Unknown, answers.Verify(1, 2, "10"))
+}
+
+func TestSaveAndLoadAnswers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "answers")
+	assert.Nil(t, err)
+
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "answers.json")
+
+	// A missing file has no answers yet
+	answers, err := LoadAnswers(path)
+	assert.Nil(t, err)
+	assert.Empty(t, answers)
+
+	answers.Set(7, 2, "4374895")
+	assert.Nil(t, answers.Save(path))
+
+	raw, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\n  \"7\": {\n    \"2\": \"4374895\"\n  }\n}\n", string(raw))
+
+	loaded, err := LoadAnswers(path)
+	assert.Nil(t, err)
+	assert.Equal(t, answers, loaded)
+
+	assert.Nil(t, ioutil.WriteFile(path, []byte("nope"), 0o644))
+
+	_, err = LoadAnswers(path)
+	assert.Contains(t, err.Error(), "reading answers from")
+}
+
+func TestDefaultAnswers(t *testing.T) {
+	dir, err := Dir()
+	assert.Nil(t, err)
+
+	answers, err := LoadAnswers(AnswersPath(dir))
+	assert.Nil(t, err)
+
+	answer, ok := answers.Get(1, 1)
+	assert.True(t, ok)
+	assert.Equal(t, Answer("3232358"), answer)
+}
+
+func TestDir(t *testing.T) {
+	// The tests run inside the solutions directory
+	wd, err := os.Getwd()
+	assert.Nil(t, err)
+
+	dir, err := Dir()
+	assert.Nil(t, err)
+	assert.Equal(t, wd, dir)
+
+	// Anywhere below the repository finds it too
+	dir, err = findDir(filepath.Join(wd, "day-01", "testdata"))
+	assert.Nil(t, err)
+	assert.Equal(t, wd, dir)
+
+	os.Setenv(SolutionsDirEnv, "/tmp/solutions")
+	defer os.Unsetenv(SolutionsDirEnv)
+
+	dir, err = Dir()
+	assert.Nil(t, err)
+	assert.Equal(t, "/tmp/solutions", dir)
+}
+
+func TestDirOutsideRepository(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "elsewhere")
+	assert.Nil(t, err)
+
+	defer os.RemoveAll(tmp)
+
+	// Some other module is not the repository
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(tmp, "go.mod"), []byte("module example.com/other\n"), 0o644))
+
+	_, err = findDir(tmp)
+	assert.Equal(t, "no solutions directory in or above "+tmp+", set AOC_SOLUTIONS_DIR to use one from elsewhere", err.Error())
+}
@@ -0,0 +1,184 @@
+package solutions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Timing sums up running a part many times.
+type Timing struct {
+	Runs int           `json:"runs"`
+	Mean time.Duration `json:"mean"`
+	P50  time.Duration `json:"p50"`
+	P99  time.Duration `json:"p99"`
+	// Average allocations and bytes allocated per run
+	Allocs uint64 `json:"allocs"`
+	Bytes  uint64 `json:"bytes"`
+}
+
+// Measure runs a part of a solution runs times, parsing the input is included in every run.
+func Measure(
+	ctx context.Context,
+	logger zerolog.Logger,
+	solution Solution,
+	part int,
+	input string,
+	runs int,
+) (Timing, error) {
+	if runs < 1 {
+		return Timing{}, fmt.Errorf("runs must be at least 1: %d", runs)
+	}
+
+	durations := make([]time.Duration, runs)
+
+	var before, after runtime.MemStats
+
+	runtime.ReadMemStats(&before)
+
+	for i := range durations {
+		if err := ctx.Err(); err != nil {
+			return Timing{}, err
+		}
+
+		start := time.Now()
+
+		if _, err := Run(ctx, logger, solution, part, input); err != nil {
+			return Timing{}, err
+		}
+
+		durations[i] = time.Since(start)
+	}
+
+	runtime.ReadMemStats(&after)
+
+	timing := summarize(durations)
+	timing.Allocs = (after.Mallocs - before.Mallocs) / uint64(runs)
+	timing.Bytes = (after.TotalAlloc - before.TotalAlloc) / uint64(runs)
+
+	return timing, nil
+}
+
+// Work out the mean and percentiles of some durations.
+func summarize(durations []time.Duration) Timing {
+	sorted := make([]time.Duration, len(durations))
+	copy(sorted, durations)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	var total time.Duration
+	for _, duration := range sorted {
+		total += duration
+	}
+
+	return Timing{
+		Runs: len(sorted),
+		Mean: total / time.Duration(len(sorted)),
+		P50:  percentile(sorted, 0.50),
+		P99:  percentile(sorted, 0.99),
+	}
+}
+
+// Nearest rank percentile of already sorted durations.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
+
+// Comparison is how much a timing changed from its baseline, 0.1 is 10% more.
+type Comparison struct {
+	Time   float64
+	Allocs float64
+}
+
+// Regressed checks if either the time or allocations grew by more than threshold.
+func (c Comparison) Regressed(threshold float64) bool {
+	return c.Time > threshold || c.Allocs > threshold
+}
+
+// Baseline is a saved set of timings to compare against, by day and then part.
+type Baseline map[int]map[int]Timing
+
+// LoadBaseline reads a baseline file, a missing file has no timings.
+func LoadBaseline(path string) (Baseline, error) {
+	raw, err := ioutil.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Baseline{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	baseline := Baseline{}
+	if err := json.Unmarshal(raw, &baseline); err != nil {
+		return nil, fmt.Errorf("reading baseline from %s: %w", path, err)
+	}
+
+	return baseline, nil
+}
+
+// Save the baseline to a file.
+func (b Baseline) Save(path string) error {
+	raw, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append(raw, '\n'), 0o644)
+}
+
+// Get the baseline timing of a part.
+func (b Baseline) Get(day int, part int) (Timing, bool) {
+	timing, ok := b[day][part]
+
+	return timing, ok
+}
+
+// Set the baseline timing of a part.
+func (b Baseline) Set(day int, part int, timing Timing) {
+	if b[day] == nil {
+		b[day] = make(map[int]Timing)
+	}
+
+	b[day][part] = timing
+}
+
+// Compare a timing against the baseline, times are compared by their median.
+func (b Baseline) Compare(day int, part int, timing Timing) (Comparison, bool) {
+	known, ok := b.Get(day, part)
+	if !ok {
+		return Comparison{}, false
+	}
+
+	return Comparison{
+		Time:   change(float64(known.P50), float64(timing.P50)),
+		Allocs: change(float64(known.Allocs), float64(timing.Allocs)),
+	}, true
+}
+
+func change(before float64, after float64) float64 {
+	if before == 0 {
+		if after == 0 {
+			return 0
+		}
+
+		return math.Inf(1)
+	}
+
+	return (after - before) / before
+}
@@ -0,0 +1,95 @@
+package solutions
+
+import (
+	"context"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarize(t *testing.T) {
+	durations := make([]time.Duration, 100)
+	for i := range durations {
+		// Out of order on purpose
+		durations[i] = time.Duration(100-i) * time.Millisecond
+	}
+
+	timing := summarize(durations)
+
+	assert.Equal(t, 100, timing.Runs)
+	assert.Equal(t, 50500*time.Microsecond, timing.Mean)
+	assert.Equal(t, 50*time.Millisecond, timing.P50)
+	assert.Equal(t, 99*time.Millisecond, timing.P99)
+
+	timing = summarize([]time.Duration{time.Second})
+	assert.Equal(t, time.Second, timing.P50)
+	assert.Equal(t, time.Second, timing.P99)
+}
+
+func TestMeasure(t *testing.T) {
+	timing, err := Measure(context.Background(), zerolog.Nop(), fakeSolution{day: 1}, 1, "10", 5)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, timing.Runs)
+	assert.True(t, timing.P50 <= timing.P99)
+
+	_, err = Measure(context.Background(), zerolog.Nop(), fakeSolution{day: 1}, 1, "10", 0)
+	assert.Equal(t, "runs must be at least 1: 0", err.Error())
+
+	_, err = Measure(context.Background(), zerolog.Nop(), fakeSolution{day: 1}, 3, "10", 1)
+	assert.Equal(t, "part must be 1 or 2: 3", err.Error())
+}
+
+func TestBaselineCompare(t *testing.T) {
+	baseline := Baseline{}
+
+	_, ok := baseline.Compare(1, 1, Timing{P50: time.Second})
+	assert.False(t, ok)
+
+	baseline.Set(1, 1, Timing{P50: time.Second, Allocs: 100})
+
+	comparison, ok := baseline.Compare(1, 1, Timing{P50: 1500 * time.Millisecond, Allocs: 100})
+	assert.True(t, ok)
+	assert.Equal(t, Comparison{Time: 0.5, Allocs: 0}, comparison)
+	assert.True(t, comparison.Regressed(0.1))
+	assert.False(t, comparison.Regressed(0.6))
+
+	comparison, _ = baseline.Compare(1, 1, Timing{P50: 900 * time.Millisecond, Allocs: 50})
+	assert.InDelta(t, -0.1, comparison.Time, 0.0001)
+	assert.Equal(t, -0.5, comparison.Allocs)
+	assert.False(t, comparison.Regressed(0.1))
+
+	baseline.Set(1, 2, Timing{P50: time.Second})
+	comparison, _ = baseline.Compare(1, 2, Timing{P50: time.Second, Allocs: 1})
+	assert.True(t, math.IsInf(comparison.Allocs, 1))
+}
+
+func TestSaveAndLoadBaseline(t *testing.T) {
+	dir, err := ioutil.TempDir("", "baseline")
+	assert.Nil(t, err)
+
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "baseline.json")
+
+	baseline, err := LoadBaseline(path)
+	assert.Nil(t, err)
+	assert.Empty(t, baseline)
+
+	baseline.Set(2, 1, Timing{Runs: 3, Mean: 2, P50: 2, P99: 3, Allocs: 10, Bytes: 100})
+	assert.Nil(t, baseline.Save(path))
+
+	loaded, err := LoadBaseline(path)
+	assert.Nil(t, err)
+	assert.Equal(t, baseline, loaded)
+
+	assert.Nil(t, ioutil.WriteFile(path, []byte("nope"), 0o644))
+
+	_, err = LoadBaseline(path)
+	assert.Contains(t, err.Error(), "reading baseline from")
+}
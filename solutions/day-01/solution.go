@@ -1,32 +1,18 @@
-package main
+package day01
 
 import (
+	"context"
 	_ "embed"
-	"log"
-	"strconv"
-	"strings"
+
+	"github.com/giodamelio/aoc-2020-go/parse"
+	"github.com/giodamelio/aoc-2020-go/solutions"
+	"github.com/rs/zerolog"
 )
 
 // Read the raw input
 //go:embed input.txt
 var rawInput string
 
-func parseInput(input string) ([]int, error) {
-	bytes := strings.Split(strings.TrimSpace(input), "\n")
-	numbers := make([]int, len(bytes))
-
-	for index, programByte := range bytes {
-		number, err := strconv.Atoi(programByte)
-		if err != nil {
-			return nil, err
-		}
-
-		numbers[index] = (number)
-	}
-
-	return numbers, nil
-}
-
 func calculateMass(mass int) int {
 	// `/` does floor division with integers
 	return mass/3 - 2
@@ -51,8 +37,8 @@ func calculateMassWithFuel(mass int) int {
 	return totalMass
 }
 
-func part1(log *log.Logger, input []int) int {
-	log.Println("Day 1 Part 1")
+func part1(logger zerolog.Logger, input []int) int {
+	logger.Info().Msg("Day 1 Part 1")
 
 	sum := int(0)
 	for _, moduleMass := range input {
@@ -62,8 +48,8 @@ func part1(log *log.Logger, input []int) int {
 	return sum
 }
 
-func part2(log *log.Logger, input []int) int {
-	log.Println("Day 1 Part 2")
+func part2(logger zerolog.Logger, input []int) int {
+	logger.Info().Msg("Day 1 Part 2")
 
 	sum := int(0)
 	for _, moduleMass := range input {
@@ -72,3 +58,30 @@ func part2(log *log.Logger, input []int) int {
 
 	return sum
 }
+
+// Solution to day 1.
+type Solution struct{}
+
+func init() {
+	solutions.Register(Solution{})
+}
+
+func (Solution) Day() int {
+	return 1
+}
+
+func (Solution) Input() string {
+	return rawInput
+}
+
+func (Solution) Parse(input string) (interface{}, error) {
+	return parse.Ints(input, "\n")
+}
+
+func (Solution) Part1(ctx context.Context, logger zerolog.Logger, input interface{}) (solutions.Answer, error) {
+	return solutions.Int(int64(part1(logger, input.([]int)))), nil
+}
+
+func (Solution) Part2(ctx context.Context, logger zerolog.Logger, input interface{}) (solutions.Answer, error) {
+	return solutions.Int(int64(part2(logger, input.([]int)))), nil
+}
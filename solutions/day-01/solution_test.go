@@ -1,38 +1,15 @@
-package main
+package day01
 
 import (
-	"io/ioutil"
-	"log"
 	"testing"
 
-	"github.com/stretchr/testify/assert"
+	"github.com/giodamelio/aoc-2020-go/solutions/solutiontest"
 )
 
-var discardLog = log.New(ioutil.Discard, "", 0)
-
-func TestCalculateMass(t *testing.T) {
-	assert.Equal(t, int(2), calculateMass(12))
-	assert.Equal(t, int(2), calculateMass(14))
-	assert.Equal(t, int(654), calculateMass(1969))
-	assert.Equal(t, int(33583), calculateMass(100756))
-}
-
-func TestCalculateMassWithFuel(t *testing.T) {
-	assert.Equal(t, int(2), calculateMassWithFuel(14))
-	assert.Equal(t, int(966), calculateMassWithFuel(1969))
-	assert.Equal(t, int(50346), calculateMassWithFuel(100756))
+func TestExamples(t *testing.T) {
+	solutiontest.Examples(t, Solution{})
 }
 
-func TestPart1(t *testing.T) {
-	parsedInput, err := parseInput(rawInput)
-	assert.Nil(t, err)
-
-	assert.Equal(t, int(3232358), part1(discardLog, parsedInput))
-}
-
-func TestPart2(t *testing.T) {
-	parsedInput, err := parseInput(rawInput)
-	assert.Nil(t, err)
-
-	assert.Equal(t, int(4845669), part2(discardLog, parsedInput))
+func TestSolution(t *testing.T) {
+	solutiontest.Verify(t, Solution{})
 }
@@ -1,23 +1,24 @@
-package main
+package day02
 
 import (
+	"context"
 	_ "embed"
-	"io/ioutil"
-	"log"
+	"fmt"
 
 	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/solutions"
+	"github.com/rs/zerolog"
 )
 
-var discardLogger = log.New(ioutil.Discard, "", 0)
-
 // Read the raw input
 //go:embed input.txt
 var rawInput string
 
-func part1(log *log.Logger, input []intcode.AddressValue) int64 {
-	log.Println("Day 2 Part 1")
+func part1(logger zerolog.Logger, input []intcode.AddressValue) int64 {
+	logger.Info().Msg("Day 2 Part 1")
 
-	computer := intcode.NewComputer(input)
+	computer := intcode.NewComputerWithProfile(input, intcode.Day2Profile)
+	computer.SetLogger(logger)
 
 	computer.Memory.Set(1, 12)
 	computer.Memory.Set(2, 2)
@@ -26,26 +27,63 @@ func part1(log *log.Logger, input []intcode.AddressValue) int64 {
 	return int64(computer.Memory.Get(0))
 }
 
-func part2(log *log.Logger, input []intcode.AddressValue) int64 {
-	log.Println("Day 2 Part 2")
+func part2(ctx context.Context, logger zerolog.Logger, input []intcode.AddressValue) (int64, error) {
+	logger.Info().Msg("Day 2 Part 2")
 
 	max := 99
 	for a := 0; a <= max; a++ {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
 		for b := 0; b <= max; b++ {
 			// Make a new copy of the input
 			inputCopy := make([]intcode.AddressValue, len(input))
 			copy(inputCopy, input)
 
-			computer := intcode.NewComputer(inputCopy)
+			computer := intcode.NewComputerWithProfile(inputCopy, intcode.Day2Profile)
+			computer.SetLogger(logger)
 			computer.Memory.Set(1, intcode.AddressValue(a))
 			computer.Memory.Set(2, intcode.AddressValue(b))
 			computer.Run()
 
 			if computer.Memory.Get(0) == 19690720 {
-				return int64(100*a + b)
+				return int64(100*a + b), nil
 			}
 		}
 	}
 
-	panic("Should never happen")
+	return 0, fmt.Errorf("no noun and verb give %d", 19690720)
+}
+
+// Solution to day 2.
+type Solution struct{}
+
+func init() {
+	solutions.Register(Solution{})
+}
+
+func (Solution) Day() int {
+	return 2
+}
+
+func (Solution) Input() string {
+	return rawInput
+}
+
+func (Solution) Parse(input string) (interface{}, error) {
+	return intcode.ParseInput(input)
+}
+
+func (Solution) Part1(ctx context.Context, logger zerolog.Logger, input interface{}) (solutions.Answer, error) {
+	return solutions.Int(part1(logger, input.([]intcode.AddressValue))), nil
+}
+
+func (Solution) Part2(ctx context.Context, logger zerolog.Logger, input interface{}) (solutions.Answer, error) {
+	answer, err := part2(ctx, logger, input.([]intcode.AddressValue))
+	if err != nil {
+		return "", err
+	}
+
+	return solutions.Int(answer), nil
 }
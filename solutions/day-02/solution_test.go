@@ -1,22 +1,26 @@
-package main
+package day02
 
 import (
+	"context"
 	"testing"
 
 	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/solutions/solutiontest"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 )
 
-func TestPart1(t *testing.T) {
-	parsedInput, err := intcode.ParseInput(rawInput)
-	assert.Nil(t, err)
-
-	assert.Equal(t, int64(12490719), part1(discardLogger, parsedInput))
+func TestSolution(t *testing.T) {
+	solutiontest.Verify(t, Solution{})
 }
 
-func TestPart2(t *testing.T) {
+func TestPart2Cancelled(t *testing.T) {
 	parsedInput, err := intcode.ParseInput(rawInput)
 	assert.Nil(t, err)
 
-	assert.Equal(t, int64(2003), part2(discardLogger, parsedInput))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = part2(ctx, zerolog.Nop(), parsedInput)
+	assert.Equal(t, context.Canceled, err)
 }
@@ -1,20 +1,25 @@
-package main
+package day05
 
 import (
+	"context"
 	_ "embed"
-	"log"
+	"fmt"
+	"time"
 
 	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/solutions"
+	"github.com/rs/zerolog"
 )
 
 // Read the raw input
 //go:embed input.txt
 var rawInput string
 
-func part1(input []intcode.AddressValue) []intcode.AddressValue {
-	log.Println("Day 5 Part 1")
+func part1(logger zerolog.Logger, input []intcode.AddressValue) []intcode.AddressValue {
+	logger.Info().Msg("Day 5 Part 1")
 
-	computer := intcode.NewComputer(input)
+	computer := intcode.NewComputerWithProfile(input, intcode.Day5Profile)
+	computer.SetLogger(logger)
 
 	// Select Air Conditioning Unit
 	sendInput := func() {
@@ -41,10 +46,15 @@ func part1(input []intcode.AddressValue) []intcode.AddressValue {
 	return <-allOutputs
 }
 
-func part2(input []intcode.AddressValue) intcode.AddressValue {
-	log.Println("Day 5 Part 2")
+func part2(logger zerolog.Logger, input []intcode.AddressValue) (intcode.AddressValue, error) {
+	logger.Info().Msg("Day 5 Part 2")
 
-	computer := intcode.NewComputer(input)
+	computer := intcode.NewComputerWithProfile(input, intcode.Day5Profile)
+	computer.SetLogger(logger)
+	computer.Name = "thermal-radiator-controller"
+
+	// Only the first output is read, drop anything after it instead of blocking forever
+	computer.SetIOTimeout(time.Second, intcode.Drop)
 
 	// Select Air Conditioning Unit
 	sendInput := func() {
@@ -54,14 +64,68 @@ func part2(input []intcode.AddressValue) intcode.AddressValue {
 
 	// Listen for outputs and when they are done send them on a channel
 	outputChan := computer.Output
-	output := make(chan intcode.AddressValue)
+	output := make(chan intcode.AddressValue, 1)
 
+	// The output channel is closed without a value if the computer halts before sending one
 	forwardOutputs := func() {
-		output <- <-outputChan
+		if value, ok := <-outputChan; ok {
+			output <- value
+		}
+
+		close(output)
 	}
 	go forwardOutputs()
 
 	computer.Run()
 
-	return <-output
+	value, ok := <-output
+	if !ok {
+		return 0, fmt.Errorf("%s halted without a diagnostic code", computer.Name)
+	}
+
+	return value, nil
+}
+
+// Solution to day 5.
+type Solution struct{}
+
+func init() {
+	solutions.Register(Solution{})
+}
+
+func (Solution) Day() int {
+	return 5
+}
+
+func (Solution) Input() string {
+	return rawInput
+}
+
+func (Solution) Parse(input string) (interface{}, error) {
+	return intcode.ParseInput(input)
+}
+
+// Part1 answers with the diagnostic code, every test before it has to pass with a zero.
+func (Solution) Part1(ctx context.Context, logger zerolog.Logger, input interface{}) (solutions.Answer, error) {
+	outputs := part1(logger, input.([]intcode.AddressValue))
+	if len(outputs) == 0 {
+		return "", fmt.Errorf("no diagnostic code was output")
+	}
+
+	for index, output := range outputs[:len(outputs)-1] {
+		if output != 0 {
+			return "", fmt.Errorf("diagnostic test %d failed: %d", index, output)
+		}
+	}
+
+	return solutions.Int(int64(outputs[len(outputs)-1])), nil
+}
+
+func (Solution) Part2(ctx context.Context, logger zerolog.Logger, input interface{}) (solutions.Answer, error) {
+	output, err := part2(logger, input.([]intcode.AddressValue))
+	if err != nil {
+		return "", err
+	}
+
+	return solutions.Int(int64(output)), nil
 }
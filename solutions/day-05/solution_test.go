@@ -1,46 +1,24 @@
-package main
+package day05
 
 import (
-	"os"
 	"testing"
 
 	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/solutions/solutiontest"
 	"github.com/rs/zerolog"
-	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
 )
 
-func init() {
-	out := zerolog.NewConsoleWriter()
-	out.Out = os.Stderr
-	out.NoColor = true
-	log.Logger = log.Output(out)
-
-	zerolog.SetGlobalLevel(zerolog.TraceLevel)
+func TestExamples(t *testing.T) {
+	solutiontest.Examples(t, Solution{})
 }
 
-func TestPart1(t *testing.T) {
-	parsedInput, err := intcode.ParseInput(rawInput)
-	assert.Nil(t, err)
-
-	output := part1(parsedInput)
-	allButLast := output[:len(output)-1]
-	last := output[len(output)-1]
-
-	// Ensure the last output is the solution
-	assert.Equal(t, intcode.AddressValue(4511442), last)
-
-	// Ensure that all the preceding outputs are zero
-	for _, outputElement := range allButLast {
-		assert.Equal(t, intcode.AddressValue(0), outputElement)
-	}
+func TestSolution(t *testing.T) {
+	solutiontest.Verify(t, Solution{})
 }
 
-func TestPart2(t *testing.T) {
-	parsedInput, err := intcode.ParseInput(rawInput)
-	assert.Nil(t, err)
-
-	output := part2(parsedInput)
+func TestPart2WithoutOutput(t *testing.T) {
+	_, err := part2(zerolog.Nop(), []intcode.AddressValue{3, 0, 99})
 
-	assert.Equal(t, intcode.AddressValue(12648139), output)
+	assert.Equal(t, "thermal-radiator-controller halted without a diagnostic code", err.Error())
 }
@@ -1,157 +1,159 @@
-package main
+package day07
 
 import (
+	"context"
 	_ "embed"
+	"fmt"
 
 	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/intcode/pipeline"
+	"github.com/giodamelio/aoc-2020-go/solutions"
 	"github.com/gitchander/permutation"
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 )
 
 // Read the raw input
 //go:embed input.txt
 var rawInput string
 
-func pipe(from chan intcode.AddressValue, to []chan intcode.AddressValue) {
-	for i := range from {
-		for _, t := range to {
-			t <- i
-		}
+func amplifiers(logger zerolog.Logger, program []intcode.AddressValue, count int) []*intcode.Computer {
+	computers := make([]*intcode.Computer, count)
+
+	for i := range computers {
+		computers[i] = intcode.NewComputerWithProfile(program, intcode.Day5Profile)
+		computers[i].Name = fmt.Sprintf("amplifier-%c", 'A'+i)
+		computers[i].SetLogger(logger)
 	}
-}
 
-func send(to chan intcode.AddressValue, value intcode.AddressValue) {
-	to <- value
+	return computers
 }
 
-func amplifierChain(program []intcode.AddressValue, phaseSequence []int) int {
-	computerA := intcode.NewComputer(program)
-	computerB := intcode.NewComputer(program)
-	computerC := intcode.NewComputer(program)
-	computerD := intcode.NewComputer(program)
-	computerE := intcode.NewComputer(program)
-
-	// Start the computers
-	go computerA.Run()
-	go computerB.Run()
-	go computerC.Run()
-	go computerD.Run()
-	go computerE.Run()
-
-	// Pass the phase settings
-	send(computerA.Input, intcode.AddressValue(phaseSequence[0]))
-	send(computerB.Input, intcode.AddressValue(phaseSequence[1]))
-	send(computerC.Input, intcode.AddressValue(phaseSequence[2]))
-	send(computerD.Input, intcode.AddressValue(phaseSequence[3]))
-	send(computerE.Input, intcode.AddressValue(phaseSequence[4]))
-
-	// Chain the computers inputs and outputs togather
-	go pipe(computerA.Output, []chan intcode.AddressValue{computerB.Input})
-	go pipe(computerB.Output, []chan intcode.AddressValue{computerC.Input})
-	go pipe(computerC.Output, []chan intcode.AddressValue{computerD.Input})
-	go pipe(computerD.Output, []chan intcode.AddressValue{computerE.Input})
+func phases(phaseSequence []int) []intcode.AddressValue {
+	values := make([]intcode.AddressValue, len(phaseSequence))
 
-	// Pass data to the start of the chain
-	go send(computerA.Input, 0)
+	for i, phase := range phaseSequence {
+		values[i] = intcode.AddressValue(phase)
+	}
 
-	return int(<-computerE.Output)
+	return values
 }
 
-func amplifierChainFeedbackMode(program []intcode.AddressValue, phaseSequence []int) int {
-	computerA := intcode.NewComputer(program)
-	computerB := intcode.NewComputer(program)
-	computerC := intcode.NewComputer(program)
-	computerD := intcode.NewComputer(program)
-	computerE := intcode.NewComputer(program)
-
-	// Start the computers
-	go computerA.Run()
-	go computerB.Run()
-	go computerC.Run()
-	go computerD.Run()
-	go computerE.Run()
-
-	// Pass the phase settings
-	send(computerA.Input, intcode.AddressValue(phaseSequence[0]))
-	send(computerB.Input, intcode.AddressValue(phaseSequence[1]))
-	send(computerC.Input, intcode.AddressValue(phaseSequence[2]))
-	send(computerD.Input, intcode.AddressValue(phaseSequence[3]))
-	send(computerE.Input, intcode.AddressValue(phaseSequence[4]))
-
-	// Chain the computers inputs and outputs togather
-	go pipe(computerA.Output, []chan intcode.AddressValue{computerB.Input})
-	go pipe(computerB.Output, []chan intcode.AddressValue{computerC.Input})
-	go pipe(computerC.Output, []chan intcode.AddressValue{computerD.Input})
-	go pipe(computerD.Output, []chan intcode.AddressValue{computerE.Input})
-
-	// Hook E back up to A, but record all of it's outputs to a second channel
-	computerEOutputs := make(chan intcode.AddressValue)
-
-	pipeUnlessHalted := func() {
-		for i := range computerE.Output {
-			computerEOutputs <- i
-
-			if computerE.State != "halted" {
-				computerA.Input <- i
-			}
-		}
+// Run the amplifiers and return the last signal the final one sends.
+func runAmplifiers(logger zerolog.Logger, amplifiers *pipeline.Pipeline, count int) (int, error) {
+	lastAmplifier := amplifiers.Tap(count - 1)
 
-		close(computerEOutputs)
+	// Pass data to the start of the chain
+	amplifiers.Send(0, 0)
+
+	if err := amplifiers.Run(); err != nil {
+		return 0, err
 	}
-	go pipeUnlessHalted()
 
-	// Pass data to the start of the chain
-	go send(computerA.Input, 0)
-	// Listen to all the outputs from computerE, output just the last one
-	lastComputerEOutput := make(chan intcode.AddressValue)
+	last, ok := lastAmplifier.Last()
+	if !ok {
+		return 0, fmt.Errorf("amplifier-%c never sent a signal", 'A'+count-1)
+	}
 
-	returnLastItem := func() {
-		var last intcode.AddressValue
+	logger.Debug().Int64("value", int64(last)).Msg("Last amplifier output")
 
-		for out := range computerEOutputs {
-			log.Info().Int64("value", int64(out)).Msg("Computer E output")
-			last = out
-		}
+	return int(last), nil
+}
 
-		lastComputerEOutput <- last
-	}
-	go returnLastItem()
+func amplifierChain(logger zerolog.Logger, program []intcode.AddressValue, phaseSequence []int) (int, error) {
+	count := len(phaseSequence)
+	chain := pipeline.Chain(amplifiers(logger, program, count), phases(phaseSequence))
+
+	return runAmplifiers(logger, chain, count)
+}
+
+func amplifierChainFeedbackMode(
+	logger zerolog.Logger,
+	program []intcode.AddressValue,
+	phaseSequence []int,
+) (int, error) {
+	count := len(phaseSequence)
+	ring := pipeline.Ring(amplifiers(logger, program, count), phases(phaseSequence))
 
-	return int(<-lastComputerEOutput)
+	return runAmplifiers(logger, ring, count)
 }
 
-func part1(input []intcode.AddressValue) int {
-	log.Info().Msg("Day 7 Part 1")
+type amplifierRunner func(zerolog.Logger, []intcode.AddressValue, []int) (int, error)
 
-	phaseSettingPermutation := []int{0, 1, 2, 3, 4}
-	permutations := permutation.New(permutation.IntSlice(phaseSettingPermutation))
+// Try every ordering of the phase settings and return the strongest signal.
+func maxSignal(
+	ctx context.Context,
+	logger zerolog.Logger,
+	input []intcode.AddressValue,
+	phaseSettings []int,
+	run amplifierRunner,
+) (int, error) {
+	permutations := permutation.New(permutation.IntSlice(phaseSettings))
 
 	maxOutput := 0
 
 	for permutations.Next() {
-		output := amplifierChain(input, phaseSettingPermutation)
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		output, err := run(logger, input, phaseSettings)
+		if err != nil {
+			return 0, err
+		}
+
 		if output > maxOutput {
 			maxOutput = output
 		}
 	}
 
-	return maxOutput
+	return maxOutput, nil
 }
 
-func part2(input []intcode.AddressValue) int {
-	log.Info().Msg("Day 7 Part 2")
+func part1(ctx context.Context, logger zerolog.Logger, input []intcode.AddressValue) (int, error) {
+	logger.Info().Msg("Day 7 Part 1")
 
-	phaseSettingPermutation := []int{5, 6, 7, 8, 9}
-	permutations := permutation.New(permutation.IntSlice(phaseSettingPermutation))
+	return maxSignal(ctx, logger, input, []int{0, 1, 2, 3, 4}, amplifierChain)
+}
 
-	maxOutput := 0
+func part2(ctx context.Context, logger zerolog.Logger, input []intcode.AddressValue) (int, error) {
+	logger.Info().Msg("Day 7 Part 2")
 
-	for permutations.Next() {
-		output := amplifierChainFeedbackMode(input, phaseSettingPermutation)
-		if output > maxOutput {
-			maxOutput = output
-		}
+	return maxSignal(ctx, logger, input, []int{5, 6, 7, 8, 9}, amplifierChainFeedbackMode)
+}
+
+// Solution to day 7.
+type Solution struct{}
+
+func init() {
+	solutions.Register(Solution{})
+}
+
+func (Solution) Day() int {
+	return 7
+}
+
+func (Solution) Input() string {
+	return rawInput
+}
+
+func (Solution) Parse(input string) (interface{}, error) {
+	return intcode.ParseInput(input)
+}
+
+func (Solution) Part1(ctx context.Context, logger zerolog.Logger, input interface{}) (solutions.Answer, error) {
+	answer, err := part1(ctx, logger, input.([]intcode.AddressValue))
+	if err != nil {
+		return "", err
+	}
+
+	return solutions.Int(int64(answer)), nil
+}
+
+func (Solution) Part2(ctx context.Context, logger zerolog.Logger, input interface{}) (solutions.Answer, error) {
+	answer, err := part2(ctx, logger, input.([]intcode.AddressValue))
+	if err != nil {
+		return "", err
 	}
 
-	return maxOutput
+	return solutions.Int(int64(answer)), nil
 }
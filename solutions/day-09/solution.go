@@ -0,0 +1,122 @@
+package day09
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/intcode/scheduler"
+	"github.com/giodamelio/aoc-2020-go/solutions"
+	"github.com/rs/zerolog"
+)
+
+// Read the raw input
+//go:embed input.txt
+var rawInput string
+
+// Modes the BOOST program can be started in.
+const (
+	testMode        = 1
+	sensorBoostMode = 2
+)
+
+// Run the BOOST program in a mode and collect everything it outputs.
+func boost(logger zerolog.Logger, program []intcode.AddressValue, mode intcode.AddressValue) ([]intcode.AddressValue, error) {
+	computer := intcode.NewComputerWithProfile(program, intcode.Day9Profile)
+	computer.Name = "boost"
+	computer.SetLogger(logger)
+
+	runner := scheduler.New()
+	runner.Logger = logger
+	process := runner.Add(computer)
+
+	var outputs []intcode.AddressValue
+
+	process.OnOutput = func(value intcode.AddressValue) error {
+		outputs = append(outputs, value)
+
+		return nil
+	}
+
+	process.Send(mode)
+
+	if err := runner.Run(); err != nil {
+		return nil, err
+	}
+
+	return outputs, nil
+}
+
+// Run the BOOST program and return the single value it outputs when everything works.
+func boostResult(
+	logger zerolog.Logger,
+	program []intcode.AddressValue,
+	mode intcode.AddressValue,
+) (intcode.AddressValue, error) {
+	outputs, err := boost(logger, program, mode)
+	if err != nil {
+		return 0, err
+	}
+
+	switch len(outputs) {
+	case 0:
+		return 0, fmt.Errorf("BOOST did not output anything")
+	case 1:
+		return outputs[0], nil
+	default:
+		// Anything before the last value is an opcode BOOST thinks is broken
+		return 0, fmt.Errorf("BOOST reported malfunctioning opcodes: %v", outputs[:len(outputs)-1])
+	}
+}
+
+func part1(logger zerolog.Logger, input []intcode.AddressValue) (intcode.AddressValue, error) {
+	logger.Info().Msg("Day 9 Part 1")
+
+	return boostResult(logger, input, testMode)
+}
+
+func part2(logger zerolog.Logger, input []intcode.AddressValue) (intcode.AddressValue, error) {
+	logger.Info().Msg("Day 9 Part 2")
+
+	return boostResult(logger, input, sensorBoostMode)
+}
+
+// Solution to day 9.
+type Solution struct{}
+
+func init() {
+	solutions.Register(Solution{})
+}
+
+func (Solution) Day() int {
+	return 9
+}
+
+func (Solution) Input() string {
+	return rawInput
+}
+
+func (Solution) Parse(input string) (interface{}, error) {
+	return intcode.ParseInput(input)
+}
+
+// Part1 answers with the BOOST keycode, it is only output when every opcode works.
+func (Solution) Part1(ctx context.Context, logger zerolog.Logger, input interface{}) (solutions.Answer, error) {
+	answer, err := part1(logger, input.([]intcode.AddressValue))
+	if err != nil {
+		return "", err
+	}
+
+	return solutions.Int(int64(answer)), nil
+}
+
+// Part2 answers with the coordinates of the distress signal.
+func (Solution) Part2(ctx context.Context, logger zerolog.Logger, input interface{}) (solutions.Answer, error) {
+	answer, err := part2(logger, input.([]intcode.AddressValue))
+	if err != nil {
+		return "", err
+	}
+
+	return solutions.Int(int64(answer)), nil
+}
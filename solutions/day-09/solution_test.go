@@ -0,0 +1,34 @@
+package day09
+
+import (
+	"testing"
+
+	"github.com/giodamelio/aoc-2020-go/intcode"
+	"github.com/giodamelio/aoc-2020-go/solutions/solutiontest"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoostQuine(t *testing.T) {
+	// Outputs a copy of itself, so it can not be written as an example answer
+	program := []intcode.AddressValue{109, 1, 204, -1, 1001, 100, 1, 100, 1008, 100, 16, 101, 1006, 101, 0, 99}
+
+	outputs, err := boost(zerolog.Nop(), program, testMode)
+
+	assert.Nil(t, err)
+	assert.Equal(t, program, outputs)
+}
+
+func TestBoostResultMalfunction(t *testing.T) {
+	_, err := boostResult(zerolog.Nop(), []intcode.AddressValue{104, 203, 104, 0, 99}, testMode)
+
+	assert.Equal(t, "BOOST reported malfunctioning opcodes: [203]", err.Error())
+}
+
+func TestExamples(t *testing.T) {
+	solutiontest.Examples(t, Solution{})
+}
+
+func TestSolution(t *testing.T) {
+	solutiontest.Verify(t, Solution{})
+}
@@ -0,0 +1,88 @@
+package solutions
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/giodamelio/aoc-2020-go/parse"
+)
+
+// Example is a sample input from a puzzle description along with the answers it should give.
+//
+// Example files start with the answers, then a blank line, then the input:
+//
+//	part1: 43210
+//	part2: 139629729
+//
+//	3,15,3,16,1002,16,10,16,1,16,15,15,4,15,99,0,0
+type Example struct {
+	Name  string
+	Input string
+	// Expected answer by part, parts the example does not cover are missing
+	Answers map[int]Answer
+}
+
+// ParseExample reads the contents of an example file.
+func ParseExample(name string, raw string) (Example, error) {
+	example := Example{Name: name, Answers: make(map[int]Answer)}
+
+	parts := strings.SplitN(raw, "\n\n", 2)
+	if len(parts) != 2 {
+		return example, fmt.Errorf("example %s: missing blank line between the answers and the input", name)
+	}
+
+	header, err := parse.KeyValues(parts[0], ":")
+	if err != nil {
+		return example, fmt.Errorf("example %s: %w", name, err)
+	}
+
+	for key, value := range header {
+		part, err := strconv.Atoi(strings.TrimPrefix(key, "part"))
+		if !strings.HasPrefix(key, "part") || err != nil || part < 1 || part > 2 {
+			return example, fmt.Errorf("example %s: unknown answer %q, expected part1 or part2", name, key)
+		}
+
+		// Empty answers are placeholders to fill in later
+		if value != "" {
+			example.Answers[part] = Answer(value)
+		}
+	}
+
+	example.Input = parts[1]
+
+	return example, nil
+}
+
+// LoadExamples reads every .txt file in a directory as an example, ordered by name.
+//
+// A missing directory has no examples.
+func LoadExamples(dir string) ([]Example, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.txt"))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(paths)
+
+	var examples []Example
+
+	for _, path := range paths {
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		example, err := ParseExample(strings.TrimSuffix(filepath.Base(path), ".txt"), string(raw))
+		if err != nil {
+			return nil, err
+		}
+
+		examples = append(examples, example)
+	}
+
+	return examples, nil
+}
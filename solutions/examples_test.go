@@ -0,0 +1,57 @@
+package solutions
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseExample(t *testing.T) {
+	example, err := ParseExample("small", "part1: 2\npart2: 3\n\n12\n14\n")
+	assert.Nil(t, err)
+	assert.Equal(t, Example{
+		Name:    "small",
+		Input:   "12\n14\n",
+		Answers: map[int]Answer{1: "2", 2: "3"},
+	}, example)
+
+	// Placeholders are left out
+	example, err = ParseExample("placeholder", "part1:\npart2: 3\n\n12\n")
+	assert.Nil(t, err)
+	assert.Equal(t, map[int]Answer{2: "3"}, example.Answers)
+}
+
+func TestParseExampleErrors(t *testing.T) {
+	_, err := ParseExample("a", "part1: 2\n12\n")
+	assert.Equal(t, "example a: missing blank line between the answers and the input", err.Error())
+
+	_, err = ParseExample("b", "part1: 2\npart3: 4\n\n12\n")
+	assert.Equal(t, `example b: unknown answer "part3", expected part1 or part2`, err.Error())
+
+	_, err = ParseExample("c", "part1: 2\npart1 4\n\n12\n")
+	assert.Equal(t, `example c: line 2: missing ":" between key and value: "part1 4"`, err.Error())
+}
+
+func TestLoadExamples(t *testing.T) {
+	dir, err := ioutil.TempDir("", "examples")
+	assert.Nil(t, err)
+
+	defer os.RemoveAll(dir)
+
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "b.txt"), []byte("part2: 4\n\n2\n"), 0o644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "a.txt"), []byte("part1: 3\n\n1\n"), 0o644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "notes.md"), []byte("not an example"), 0o644))
+
+	examples, err := LoadExamples(dir)
+	assert.Nil(t, err)
+	assert.Len(t, examples, 2)
+	assert.Equal(t, "a", examples[0].Name)
+	assert.Equal(t, "b", examples[1].Name)
+
+	examples, err = LoadExamples(filepath.Join(dir, "missing"))
+	assert.Nil(t, err)
+	assert.Empty(t, examples)
+}
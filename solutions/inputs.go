@@ -0,0 +1,175 @@
+package solutions
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// InputDirEnv is the environment variable naming a directory of cached puzzle inputs.
+const InputDirEnv = "AOC_INPUT_DIR"
+
+// ErrNoInput is returned for a freshly scaffolded day, its input.txt is still empty and has no hash.
+var ErrNoInput = errors.New("no puzzle input")
+
+// Source is where an input came from.
+type Source string
+
+const (
+	// A file given on the command line
+	PathSource Source = "path"
+	// A day-NN.txt file in the input directory
+	CacheSource Source = "cache"
+	// The input.txt embedded in the day's package
+	EmbeddedSource Source = "embedded"
+)
+
+// HashState is how an input compared to the hash stored alongside it.
+type HashState string
+
+const (
+	// The input matches its hash
+	HashVerified HashState = "verified"
+	// The input had no hash, so one was recorded from it
+	HashRecorded HashState = "recorded"
+	// There is no hash, so nothing would notice the input being edited
+	HashMissing HashState = "missing"
+)
+
+// Input is a puzzle input along with where it was found.
+type Input struct {
+	Text   string
+	Source Source
+	// File the input was read from, for embedded inputs this is the checked in input.txt
+	Path string
+	Hash HashState
+}
+
+// InputProvider finds the input for a day.
+//
+// It uses Path if it is set, then day-NN.txt in CacheDir if it exists, then the embedded input.
+// Every input is checked against a sha256 file stored alongside it, like input.txt.sha256.
+// Inputs in the cache without one have it recorded the first time they are read.
+type InputProvider struct {
+	Path     string
+	CacheDir string
+	// The solutions directory, where the embedded inputs are checked in
+	Dir string
+}
+
+// NewInputProvider creates a provider for a path from the command line, the solutions directory
+// and the cache directory in the environment, the path can be empty.
+func NewInputProvider(path string, dir string) InputProvider {
+	return InputProvider{Path: path, CacheDir: os.Getenv(InputDirEnv), Dir: dir}
+}
+
+// Directory a day's package lives in, relative to the solutions directory.
+func Directory(day int) string {
+	return fmt.Sprintf("day-%02d", day)
+}
+
+// Locate the file a day's input comes from, without reading it.
+func (p InputProvider) Locate(day int) (string, Source) {
+	if p.Path != "" {
+		return p.Path, PathSource
+	}
+
+	if p.CacheDir != "" {
+		path := filepath.Join(p.CacheDir, Directory(day)+".txt")
+		if _, err := os.Stat(path); err == nil {
+			return path, CacheSource
+		}
+	}
+
+	return filepath.Join(p.Dir, Directory(day), "input.txt"), EmbeddedSource
+}
+
+// Input finds the input for a solution and checks it against its hash.
+func (p InputProvider) Input(solution Solution) (Input, error) {
+	path, source := p.Locate(solution.Day())
+	input := Input{Source: source, Path: path}
+
+	if source == EmbeddedSource {
+		input.Text = solution.Input()
+	} else {
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			return input, err
+		}
+
+		input.Text = string(raw)
+	}
+
+	// An input with a hash is checked against it, even if it has been emptied
+	verified, err := VerifyHash(path, input.Text)
+	if err != nil {
+		return input, err
+	}
+
+	if source == EmbeddedSource && !verified && strings.TrimSpace(input.Text) == "" {
+		return input, fmt.Errorf("day %d: %w in %s", solution.Day(), ErrNoInput, path)
+	}
+
+	switch {
+	case verified:
+		input.Hash = HashVerified
+	// Trust cached inputs the first time they are seen
+	case source == CacheSource:
+		if err := WriteHash(path, input.Text); err != nil {
+			return input, err
+		}
+
+		input.Hash = HashRecorded
+	default:
+		input.Hash = HashMissing
+	}
+
+	return input, nil
+}
+
+// Hash an input.
+func Hash(text string) string {
+	sum := sha256.Sum256([]byte(text))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// HashPath is where the hash of an input file is kept.
+func HashPath(path string) string {
+	return path + ".sha256"
+}
+
+// WriteHash records the hash of an input next to it, in the format sha256sum uses.
+func WriteHash(path string, text string) error {
+	line := fmt.Sprintf("%s  %s\n", Hash(text), filepath.Base(path))
+
+	return ioutil.WriteFile(HashPath(path), []byte(line), 0o644)
+}
+
+// VerifyHash checks an input against the hash recorded next to it, false if there is none.
+func VerifyHash(path string, text string) (bool, error) {
+	raw, err := ioutil.ReadFile(HashPath(path))
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	fields := strings.Fields(string(raw))
+	if len(fields) == 0 {
+		return false, fmt.Errorf("%s is empty", HashPath(path))
+	}
+
+	if actual := Hash(text); actual != fields[0] {
+		return false, fmt.Errorf("input %s has changed, its sha256 is %s but %s was recorded", path, actual, fields[0])
+	}
+
+	return true, nil
+}
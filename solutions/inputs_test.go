@@ -0,0 +1,182 @@
+package solutions
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type embeddedSolution struct {
+	fakeSolution
+	input string
+}
+
+func (s embeddedSolution) Input() string {
+	return s.input
+}
+
+func TestDirectory(t *testing.T) {
+	assert.Equal(t, "day-09", Directory(9))
+	assert.Equal(t, "day-25", Directory(25))
+}
+
+func TestHash(t *testing.T) {
+	assert.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", Hash(""))
+}
+
+func TestWriteAndVerifyHash(t *testing.T) {
+	dir, err := ioutil.TempDir("", "inputs")
+	assert.Nil(t, err)
+
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "input.txt")
+
+	verified, err := VerifyHash(path, "1,2,3\n")
+	assert.Nil(t, err)
+	assert.False(t, verified)
+
+	assert.Nil(t, WriteHash(path, "1,2,3\n"))
+
+	raw, err := ioutil.ReadFile(HashPath(path))
+	assert.Nil(t, err)
+	assert.Equal(t, Hash("1,2,3\n")+"  input.txt\n", string(raw))
+
+	verified, err = VerifyHash(path, "1,2,3\n")
+	assert.Nil(t, err)
+	assert.True(t, verified)
+
+	_, err = VerifyHash(path, "1,2,4\n")
+	assert.Equal(
+		t,
+		"input "+path+" has changed, its sha256 is "+Hash("1,2,4\n")+" but "+Hash("1,2,3\n")+" was recorded",
+		err.Error(),
+	)
+}
+
+func TestInputProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "inputs")
+	assert.Nil(t, err)
+
+	defer os.RemoveAll(dir)
+
+	solution := embeddedSolution{fakeSolution: fakeSolution{day: 24}, input: "embedded"}
+
+	// Nothing is cached so the embedded input is used, there is no hash for a day that does not exist
+	provider := InputProvider{CacheDir: dir, Dir: "."}
+
+	input, err := provider.Input(solution)
+	assert.Nil(t, err)
+	assert.Equal(t, Input{
+		Text:   "embedded",
+		Source: EmbeddedSource,
+		Path:   filepath.Join("day-24", "input.txt"),
+		Hash:   HashMissing,
+	}, input)
+
+	// A cached input is used instead, and its hash is recorded the first time
+	cached := filepath.Join(dir, "day-24.txt")
+	assert.Nil(t, ioutil.WriteFile(cached, []byte("cached"), 0o644))
+
+	input, err = provider.Input(solution)
+	assert.Nil(t, err)
+	assert.Equal(t, Input{Text: "cached", Source: CacheSource, Path: cached, Hash: HashRecorded}, input)
+	assert.FileExists(t, HashPath(cached))
+
+	input, err = provider.Input(solution)
+	assert.Nil(t, err)
+	assert.Equal(t, HashVerified, input.Hash)
+
+	assert.Nil(t, ioutil.WriteFile(cached, []byte("edited"), 0o644))
+
+	_, err = provider.Input(solution)
+	assert.Contains(t, err.Error(), "has changed")
+
+	// A path beats everything else
+	path := filepath.Join(dir, "other.txt")
+	assert.Nil(t, ioutil.WriteFile(path, []byte("other"), 0o644))
+
+	provider.Path = path
+
+	input, err = provider.Input(solution)
+	assert.Nil(t, err)
+	assert.Equal(t, Input{Text: "other", Source: PathSource, Path: path, Hash: HashMissing}, input)
+	assert.NoFileExists(t, HashPath(path))
+
+	provider.Path = filepath.Join(dir, "missing.txt")
+
+	_, err = provider.Input(solution)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestInputProviderRelocated(t *testing.T) {
+	dir, err := ioutil.TempDir("", "solutions")
+	assert.Nil(t, err)
+
+	defer os.RemoveAll(dir)
+
+	solution := embeddedSolution{fakeSolution: fakeSolution{day: 24}, input: "embedded"}
+
+	path := filepath.Join(dir, "day-24", "input.txt")
+	assert.Nil(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	assert.Nil(t, ioutil.WriteFile(path, []byte("embedded"), 0o644))
+	assert.Nil(t, WriteHash(path, "embedded"))
+
+	input, err := InputProvider{Dir: dir}.Input(solution)
+	assert.Nil(t, err)
+	assert.Equal(t, HashVerified, input.Hash)
+
+	// A solutions directory without the hashes can not check anything, but that is not an error
+	relocated := filepath.Join(dir, "relocated")
+
+	input, err = InputProvider{Dir: relocated}.Input(solution)
+	assert.Nil(t, err)
+	assert.Equal(t, Input{
+		Text:   "embedded",
+		Source: EmbeddedSource,
+		Path:   filepath.Join(relocated, "day-24", "input.txt"),
+		Hash:   HashMissing,
+	}, input)
+}
+
+func TestInputProviderNoInput(t *testing.T) {
+	solution := embeddedSolution{fakeSolution: fakeSolution{day: 24}, input: "\n"}
+
+	_, err := InputProvider{Dir: "."}.Input(solution)
+
+	assert.True(t, errors.Is(err, ErrNoInput))
+	assert.Equal(t, fmt.Sprintf("day 24: no puzzle input in %s", filepath.Join("day-24", "input.txt")), err.Error())
+}
+
+func TestInputProviderEmptiedInput(t *testing.T) {
+	dir, err := ioutil.TempDir("", "inputs")
+	assert.Nil(t, err)
+
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "input.txt")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(""), 0o644))
+	assert.Nil(t, WriteHash(path, "1,2,3\n"))
+
+	// Only a day that never had an input counts as missing one
+	_, err = InputProvider{Path: path}.Input(embeddedSolution{fakeSolution: fakeSolution{day: 24}})
+
+	assert.False(t, errors.Is(err, ErrNoInput))
+	assert.Contains(t, err.Error(), "has changed")
+}
+
+func TestNewInputProvider(t *testing.T) {
+	os.Setenv(InputDirEnv, "/tmp/inputs")
+	defer os.Unsetenv(InputDirEnv)
+
+	assert.Equal(
+		t,
+		InputProvider{Path: "input.txt", CacheDir: "/tmp/inputs", Dir: "solutions"},
+		NewInputProvider("input.txt", "solutions"),
+	)
+}
@@ -0,0 +1,96 @@
+// Package solutions keeps track of every day that has been solved.
+//
+// Days register themselves when their package is imported, import solutions/all to get them all.
+package solutions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/rs/zerolog"
+)
+
+// Answer is the printable result of a part.
+type Answer string
+
+// Int turns a number into an answer.
+func Int(value int64) Answer {
+	return Answer(strconv.FormatInt(value, 10))
+}
+
+// Solution solves both parts of a day's puzzle.
+type Solution interface {
+	// Day is the day of the puzzle, starting at 1
+	Day() int
+	// Input is the puzzle input embedded in the day's package
+	Input() string
+	// Parse the raw input into whatever the parts work with
+	Parse(input string) (interface{}, error)
+	Part1(ctx context.Context, logger zerolog.Logger, input interface{}) (Answer, error)
+	Part2(ctx context.Context, logger zerolog.Logger, input interface{}) (Answer, error)
+}
+
+var registered = make(map[int]Solution)
+
+// Register a solution, registering the same day twice is a bug so it panics.
+func Register(solution Solution) {
+	if _, ok := registered[solution.Day()]; ok {
+		panic(fmt.Errorf("day %d is already registered", solution.Day()))
+	}
+
+	registered[solution.Day()] = solution
+}
+
+// Lookup the solution to a day.
+func Lookup(day int) (Solution, bool) {
+	solution, ok := registered[day]
+
+	return solution, ok
+}
+
+// All lists every registered solution ordered by day.
+func All() []Solution {
+	list := make([]Solution, 0, len(registered))
+	for _, solution := range registered {
+		list = append(list, solution)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Day() < list[j].Day()
+	})
+
+	return list
+}
+
+// Run a single part of a solution against an input, turning a panic into an error.
+func Run(
+	ctx context.Context,
+	logger zerolog.Logger,
+	solution Solution,
+	part int,
+	input string,
+) (answer Answer, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("panic: %v", recovered)
+		}
+	}()
+
+	parsed, err := solution.Parse(input)
+	if err != nil {
+		return "", fmt.Errorf("parsing input: %w", err)
+	}
+
+	logger = logger.With().Int("day", solution.Day()).Int("part", part).Logger()
+
+	switch part {
+	case 1:
+		return solution.Part1(ctx, logger, parsed)
+	case 2:
+		return solution.Part2(ctx, logger, parsed)
+	default:
+		return "", fmt.Errorf("part must be 1 or 2: %d", part)
+	}
+}
@@ -0,0 +1,83 @@
+package solutions
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+// Counts the lines of the input in part 1 and panics in part 2.
+type fakeSolution struct {
+	day int
+}
+
+func (s fakeSolution) Day() int {
+	return s.day
+}
+
+func (s fakeSolution) Input() string {
+	return "a\nb\n"
+}
+
+func (s fakeSolution) Parse(input string) (interface{}, error) {
+	if input == "" {
+		return nil, errors.New("empty input")
+	}
+
+	return strings.Split(strings.TrimSpace(input), "\n"), nil
+}
+
+func (s fakeSolution) Part1(ctx context.Context, logger zerolog.Logger, input interface{}) (Answer, error) {
+	return Int(int64(len(input.([]string)))), nil
+}
+
+func (s fakeSolution) Part2(ctx context.Context, logger zerolog.Logger, input interface{}) (Answer, error) {
+	panic("not solved yet")
+}
+
+func TestRegister(t *testing.T) {
+	defer func() {
+		registered = make(map[int]Solution)
+	}()
+
+	Register(fakeSolution{day: 3})
+	Register(fakeSolution{day: 1})
+
+	solution, ok := Lookup(3)
+	assert.True(t, ok)
+	assert.Equal(t, 3, solution.Day())
+
+	_, ok = Lookup(2)
+	assert.False(t, ok)
+
+	list := All()
+	assert.Len(t, list, 2)
+	assert.Equal(t, 1, list[0].Day())
+	assert.Equal(t, 3, list[1].Day())
+
+	assert.PanicsWithError(t, "day 3 is already registered", func() {
+		Register(fakeSolution{day: 3})
+	})
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	solution := fakeSolution{day: 1}
+
+	answer, err := Run(ctx, zerolog.Nop(), solution, 1, solution.Input())
+	assert.Nil(t, err)
+	assert.Equal(t, Answer("2"), answer)
+
+	_, err = Run(ctx, zerolog.Nop(), solution, 2, solution.Input())
+	assert.Equal(t, "panic: not solved yet", err.Error())
+
+	_, err = Run(ctx, zerolog.Nop(), solution, 3, solution.Input())
+	assert.Equal(t, "part must be 1 or 2: 3", err.Error())
+
+	_, err = Run(ctx, zerolog.Nop(), solution, 1, "")
+	assert.Equal(t, "parsing input: empty input", err.Error())
+}
@@ -0,0 +1,150 @@
+// Package scaffold creates the files for a new day with the same layout as every other day.
+package scaffold
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/giodamelio/aoc-2020-go/solutions"
+	"github.com/giodamelio/aoc-2020-go/solutions/solutiontest"
+)
+
+//go:embed templates/*.tmpl
+var templateFiles embed.FS
+
+var templates = template.Must(template.ParseFS(templateFiles, "templates/*.tmpl"))
+
+// Day is what the templates know about the day being created.
+type Day struct {
+	Day     int
+	Package string
+}
+
+// Render a template and gofmt the result.
+func render(name string, data interface{}) ([]byte, error) {
+	var source bytes.Buffer
+	if err := templates.ExecuteTemplate(&source, name, data); err != nil {
+		return nil, err
+	}
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting %s: %w", name, err)
+	}
+
+	return formatted, nil
+}
+
+// Generate a new day inside the solutions directory root and add it to solutions/all.
+//
+// It returns the paths of every file it wrote.
+func Generate(root string, day int) ([]string, error) {
+	if day < 1 || day > 25 {
+		return nil, fmt.Errorf("day must be between 1 and 25: %d", day)
+	}
+
+	dir := filepath.Join(root, solutions.Directory(day))
+
+	if _, err := os.Stat(dir); err == nil {
+		return nil, fmt.Errorf("%s already exists", dir)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	data := Day{Day: day, Package: fmt.Sprintf("day%02d", day)}
+
+	solution, err := render("solution.go.tmpl", data)
+	if err != nil {
+		return nil, err
+	}
+
+	test, err := render("solution_test.go.tmpl", data)
+	if err != nil {
+		return nil, err
+	}
+
+	var example bytes.Buffer
+	if err := templates.ExecuteTemplate(&example, "example.txt.tmpl", data); err != nil {
+		return nil, err
+	}
+
+	examples := filepath.Join(dir, filepath.FromSlash(solutiontest.ExamplesDir))
+	if err := os.MkdirAll(examples, 0o755); err != nil {
+		return nil, err
+	}
+
+	files := []struct {
+		path     string
+		contents []byte
+	}{
+		{filepath.Join(dir, "solution.go"), solution},
+		{filepath.Join(dir, "solution_test.go"), test},
+		// Embedding needs the file to exist, paste the puzzle input into it and run aoc hash
+		{filepath.Join(dir, "input.txt"), nil},
+		// Fill in the answers and input from the puzzle description
+		{filepath.Join(examples, "example-1.txt"), example.Bytes()},
+	}
+
+	var written []string
+
+	for _, file := range files {
+		if err := ioutil.WriteFile(file.path, file.contents, 0o644); err != nil {
+			return written, err
+		}
+
+		written = append(written, file.path)
+	}
+
+	all, err := UpdateAll(root)
+	if err != nil {
+		return written, err
+	}
+
+	return append(written, all), nil
+}
+
+// List the day directories in root.
+func days(root string) ([]string, error) {
+	entries, err := ioutil.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var days []string
+
+	for _, entry := range entries {
+		if entry.IsDir() && strings.HasPrefix(entry.Name(), "day-") {
+			days = append(days, entry.Name())
+		}
+	}
+
+	sort.Strings(days)
+
+	return days, nil
+}
+
+// UpdateAll rewrites solutions/all so it imports every day directory in root.
+func UpdateAll(root string) (string, error) {
+	days, err := days(root)
+	if err != nil {
+		return "", err
+	}
+
+	source, err := render("all.go.tmpl", days)
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(root, "all", "all.go")
+
+	return path, ioutil.WriteFile(path, source, 0o644)
+}
@@ -0,0 +1,67 @@
+package scaffold
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	root, err := ioutil.TempDir("", "solutions")
+	assert.Nil(t, err)
+
+	defer os.RemoveAll(root)
+
+	assert.Nil(t, os.Mkdir(filepath.Join(root, "all"), 0o755))
+	assert.Nil(t, os.Mkdir(filepath.Join(root, "day-01"), 0o755))
+
+	written, err := Generate(root, 9)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(root, "day-09", "solution.go"),
+		filepath.Join(root, "day-09", "solution_test.go"),
+		filepath.Join(root, "day-09", "input.txt"),
+		filepath.Join(root, "day-09", "testdata", "examples", "example-1.txt"),
+		filepath.Join(root, "all", "all.go"),
+	}, written)
+
+	// The generated code is valid Go in the right package
+	for _, path := range written[:2] {
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.PackageClauseOnly)
+		assert.Nil(t, err)
+		assert.Equal(t, "day09", file.Name.Name)
+	}
+
+	solution, err := ioutil.ReadFile(written[0])
+	assert.Nil(t, err)
+	assert.Contains(t, string(solution), "//go:embed input.txt")
+	assert.Contains(t, string(solution), "\treturn 9\n")
+
+	all, err := ioutil.ReadFile(written[4])
+	assert.Nil(t, err)
+	assert.Contains(t, string(all), "\t_ \"github.com/giodamelio/aoc-2020-go/solutions/day-01\"\n")
+	assert.Contains(t, string(all), "\t_ \"github.com/giodamelio/aoc-2020-go/solutions/day-09\"\n")
+
+	_, err = Generate(root, 9)
+	assert.Equal(t, filepath.Join(root, "day-09")+" already exists", err.Error())
+
+	_, err = Generate(root, 26)
+	assert.Equal(t, "day must be between 1 and 25: 26", err.Error())
+}
+
+func TestUpdateAllUpToDate(t *testing.T) {
+	existing, err := ioutil.ReadFile("../all/all.go")
+	assert.Nil(t, err)
+
+	days, err := days("..")
+	assert.Nil(t, err)
+
+	source, err := render("all.go.tmpl", days)
+	assert.Nil(t, err)
+	assert.Equal(t, string(existing), string(source))
+}
@@ -0,0 +1,106 @@
+// Package solutiontest checks solutions against the known answers and puzzle examples in their tests.
+package solutiontest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/giodamelio/aoc-2020-go/solutions"
+	"github.com/rs/zerolog"
+)
+
+// Verify runs both parts of a solution against the answers file.
+//
+// Parts without a known answer are skipped, run `aoc accept` to record them. Freshly
+// scaffolded days without their puzzle input yet are skipped entirely.
+func Verify(t *testing.T, solution solutions.Solution) {
+	t.Helper()
+
+	dir, err := solutions.Dir()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	answers, err := solutions.LoadAnswers(solutions.AnswersPath(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the embedded input has known answers, make sure it has not been edited by accident
+	input, err := solutions.InputProvider{Dir: dir}.Input(solution)
+	if errors.Is(err, solutions.ErrNoInput) {
+		t.Skip(err)
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for part := 1; part <= 2; part++ {
+		part := part
+
+		t.Run(fmt.Sprintf("Part%d", part), func(t *testing.T) {
+			answer, err := solutions.Run(context.Background(), zerolog.Nop(), solution, part, input.Text)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			known, _ := answers.Get(solution.Day(), part)
+
+			switch answers.Verify(solution.Day(), part, answer) {
+			case solutions.Pass:
+			case solutions.Unknown:
+				t.Skipf("no known answer for day %d part %d, got %s", solution.Day(), part, answer)
+			case solutions.Fail:
+				t.Errorf("day %d part %d: expected %s, got %s", solution.Day(), part, known, answer)
+			}
+		})
+	}
+}
+
+// ExamplesDir is where Examples looks for example files, relative to the day being tested.
+const ExamplesDir = "testdata/examples"
+
+// Examples runs a solution against every example file in ExamplesDir.
+//
+// Only the parts an example has an answer for are run.
+func Examples(t *testing.T, solution solutions.Solution) {
+	t.Helper()
+
+	examples, err := solutions.LoadExamples(ExamplesDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ran := 0
+
+	for _, example := range examples {
+		for part := 1; part <= 2; part++ {
+			expected, ok := example.Answers[part]
+			if !ok {
+				continue
+			}
+
+			example := example
+			part := part
+			ran++
+
+			t.Run(fmt.Sprintf("%s/Part%d", example.Name, part), func(t *testing.T) {
+				answer, err := solutions.Run(context.Background(), zerolog.Nop(), solution, part, example.Input)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if answer != expected {
+					t.Errorf("example %s part %d: expected %s, got %s", example.Name, part, expected, answer)
+				}
+			})
+		}
+	}
+
+	if ran == 0 {
+		t.Skipf("no example answers in %s", ExamplesDir)
+	}
+}